@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/exec"
 	"strings"
 	"sync"
 	"testing"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/mockapi"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/provider"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/provider/fwprovider"
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
@@ -207,12 +209,21 @@ func protoV6TestProviderServerFactory(ctx context.Context, isVpc bool) (func() t
 		return provider.ProviderConfigure(ctx, d)
 	}
 
+	providerServerFactory, err := protoV6ProviderServerFactory(ctx, primary)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return providerServerFactory, primary, nil
+}
+
+func protoV6ProviderServerFactory(ctx context.Context, primary *schema.Provider) (func() tfprotov6.ProviderServer, error) {
 	upgradedSdkProvider, err := tf5to6server.UpgradeServer(
 		ctx,
 		primary.GRPCProvider,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	servers := []func() tfprotov6.ProviderServer{
@@ -225,8 +236,51 @@ func protoV6TestProviderServerFactory(ctx context.Context, isVpc bool) (func() t
 	muxServer, err := tf6muxserver.NewMuxServer(ctx, servers...)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// MockProtoV6ProviderFactories returns provider factories for the VPC environment which talk to the mock API server
+// instead of NCLOUD, with dummy credentials. The returned provider holds the *conn.ProviderConfig as its Meta()
+// once configured, for use in check functions.
+func MockProtoV6ProviderFactories(server *mockapi.Server) (map[string]func() (tfprotov6.ProviderServer, error), *schema.Provider) {
+	primary := provider.New(context.Background())
+	primary.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		endpoints := map[string]interface{}{}
+		for service, endpoint := range server.Endpoints() {
+			endpoints[service] = endpoint
+		}
+
+		d.Set("access_key", "mockapi-access-key")
+		d.Set("secret_key", "mockapi-secret-key")
+		d.Set("region", "KR")
+		d.Set("support_vpc", true)
+		d.Set("endpoints", []interface{}{endpoints})
+		return provider.ProviderConfigure(ctx, d)
+	}
+
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		ProviderName: func() (tfprotov6.ProviderServer, error) {
+			providerServerFactory, err := protoV6ProviderServerFactory(context.Background(), primary)
+			if err != nil {
+				return nil, err
+			}
+
+			return providerServerFactory(), nil
+		},
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	return factories, primary
+}
+
+// MockPreCheck skips the unit test when no Terraform CLI is available to run it against the mock API server
+func MockPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("Terraform CLI is not installed, set TF_ACC_TERRAFORM_PATH to run unit tests against the mock API server")
+	}
 }
@@ -0,0 +1,63 @@
+package mockapi
+
+import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+)
+
+// createDate is the creation date reported for every instance
+const createDate = "2024-01-01T00:00:00+0900"
+
+// match returns whether the optional filter parameter is unset or equal to the value
+func match(filter, value *string) bool {
+	return filter == nil || ncloud.StringValue(filter) == ncloud.StringValue(value)
+}
+
+// matchList returns whether the optional list filter parameter is empty or contains the value
+func matchList(filter []*string, value *string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	return contains(ncloud.StringListValue(filter), ncloud.StringValue(value))
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func filter[T any](values []*T, match func(value *T) bool) []*T {
+	filtered := make([]*T, 0, len(values))
+	for _, v := range values {
+		if match(v) {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
+
+// paginate returns the page of the list, with pageNo starting from 1. Unset parameters return the whole list.
+func paginate[T any](values []*T, pageNo, pageSize *int32) []*T {
+	size := int(ncloud.Int32Value(pageSize))
+	if size <= 0 {
+		return values
+	}
+
+	page := int(ncloud.Int32Value(pageNo))
+	if page < 1 {
+		page = 1
+	}
+
+	start := (page - 1) * size
+	if start >= len(values) {
+		return []*T{}
+	}
+	end := start + size
+	if end > len(values) {
+		end = len(values)
+	}
+	return values[start:end]
+}
@@ -0,0 +1,101 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// decodeParams fills an SDK request struct from the form encoded parameters. It is the reverse of the
+// encoding done by the SDK clients: fields are named after the struct field with the first letter in
+// lower case, list items are numbered from 1 and nested fields are joined with dots,
+// e.g. `networkAclRuleList.1.priority`.
+func decodeParams(params url.Values, request interface{}) error {
+	return decodeValue(params, "", reflect.ValueOf(request).Elem())
+}
+
+func decodeValue(params url.Values, prefix string, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			name := lowerFirst(v.Type().Field(i).Name)
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+			if err := decodeValue(params, name, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 1; hasParam(params, fmt.Sprintf("%s.%d", prefix, i)); i++ {
+			item := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeValue(params, fmt.Sprintf("%s.%d", prefix, i), item); err != nil {
+				return err
+			}
+			v.Set(reflect.Append(v, item))
+		}
+	case reflect.Ptr:
+		if !hasParam(params, prefix) {
+			return nil
+		}
+		value := reflect.New(v.Type().Elem())
+		if err := decodeValue(params, prefix, value.Elem()); err != nil {
+			return err
+		}
+		v.Set(value)
+	default:
+		return decodeScalar(params.Get(prefix), prefix, v)
+	}
+
+	return nil
+}
+
+func decodeScalar(s, name string, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return errInvalidParameter("%s: %s", name, err)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return errInvalidParameter("%s: %s", name, err)
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return errInvalidParameter("%s: %s", name, err)
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported parameter type %s of %s", v.Type(), name)
+	}
+	return nil
+}
+
+// hasParam returns whether the parameter or any of its nested fields is set
+func hasParam(params url.Values, name string) bool {
+	if _, ok := params[name]; ok {
+		return true
+	}
+	for k := range params {
+		if strings.HasPrefix(k, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func lowerFirst(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
@@ -0,0 +1,366 @@
+package mockapi
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// s3PathPrefix is the path of the Object Storage endpoint, which is addressed path-style
+const s3PathPrefix = "/s3/"
+
+const s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// s3OwnerID is the owner of every bucket and object
+const s3OwnerID = "mockapi"
+
+type bucketObject struct {
+	name    string
+	created time.Time
+	acl     string
+	objects map[string]*storageObject
+}
+
+type storageObject struct {
+	body         []byte
+	contentType  string
+	etag         string
+	acl          string
+	lastModified time.Time
+	metadata     map[string]string
+}
+
+type s3Error struct {
+	XMLName    xml.Name `xml:"Error"`
+	Code       string   `xml:"Code"`
+	Message    string   `xml:"Message"`
+	statusCode int
+}
+
+func (e *s3Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func errNoSuchBucket(name string) *s3Error {
+	return &s3Error{Code: "NoSuchBucket", Message: fmt.Sprintf("bucket %s does not exist", name), statusCode: http.StatusNotFound}
+}
+
+func errNoSuchKey(key string) *s3Error {
+	return &s3Error{Code: "NoSuchKey", Message: fmt.Sprintf("key %s does not exist", key), statusCode: http.StatusNotFound}
+}
+
+type ownerInfo struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName"`
+}
+
+var owner = ownerInfo{ID: s3OwnerID, DisplayName: s3OwnerID}
+
+func (s *Server) serveS3(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, s3PathPrefix), "/")
+	_, acl := r.URL.Query()["acl"]
+
+	var operation string
+	switch {
+	case bucket == "" && r.Method == http.MethodGet:
+		operation = "ListBuckets"
+	case key == "" && acl && r.Method == http.MethodGet:
+		operation = "GetBucketAcl"
+	case key == "" && acl && r.Method == http.MethodPut:
+		operation = "PutBucketAcl"
+	case key == "" && r.Method == http.MethodPut:
+		operation = "CreateBucket"
+	case key == "" && r.Method == http.MethodHead:
+		operation = "HeadBucket"
+	case key == "" && r.Method == http.MethodDelete:
+		operation = "DeleteBucket"
+	case key == "" && r.Method == http.MethodGet:
+		operation = "ListObjects"
+	case acl && r.Method == http.MethodGet:
+		operation = "GetObjectAcl"
+	case acl && r.Method == http.MethodPut:
+		operation = "PutObjectAcl"
+	case r.Method == http.MethodPut && r.Header.Get("x-amz-copy-source") != "":
+		operation = "CopyObject"
+	case r.Method == http.MethodPut:
+		operation = "PutObject"
+	case r.Method == http.MethodGet:
+		operation = "GetObject"
+	case r.Method == http.MethodHead:
+		operation = "HeadObject"
+	case r.Method == http.MethodDelete:
+		operation = "DeleteObject"
+	default:
+		writeS3Error(w, r, &s3Error{Code: "NotImplemented", Message: r.Method + " " + r.URL.Path, statusCode: http.StatusNotImplemented})
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeS3Error(w, r, &s3Error{Code: "IncompleteBody", Message: err.Error(), statusCode: http.StatusBadRequest})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if apiErr := s.callAction(operation); apiErr != nil {
+		writeS3Error(w, r, &s3Error{Code: apiErr.ReturnCode, Message: apiErr.ReturnMessage, statusCode: apiErr.StatusCode})
+		return
+	}
+
+	if operation == "ListBuckets" {
+		s.listBuckets(w)
+		return
+	}
+
+	b, ok := s.buckets.get(bucket)
+	if operation == "CreateBucket" {
+		if ok {
+			writeS3Error(w, r, &s3Error{Code: "BucketAlreadyOwnedByYou", Message: bucket, statusCode: http.StatusConflict})
+			return
+		}
+		s.buckets.put(bucket, &bucketObject{
+			name:    bucket,
+			created: time.Now().UTC(),
+			acl:     cannedACL(r),
+			objects: map[string]*storageObject{},
+		}, nil)
+		w.Header().Set("Location", "/"+bucket)
+		return
+	}
+	if !ok {
+		writeS3Error(w, r, errNoSuchBucket(bucket))
+		return
+	}
+
+	switch operation {
+	case "HeadBucket":
+	case "DeleteBucket":
+		if len(b.objects) > 0 {
+			writeS3Error(w, r, &s3Error{Code: "BucketNotEmpty", Message: bucket, statusCode: http.StatusConflict})
+			return
+		}
+		s.buckets.delete(bucket)
+		w.WriteHeader(http.StatusNoContent)
+	case "GetBucketAcl":
+		writeXML(w, accessControlPolicy(b.acl))
+	case "PutBucketAcl":
+		b.acl = cannedACL(r)
+	case "ListObjects":
+		s.listObjects(w, r, b)
+	case "PutObject":
+		o := &storageObject{
+			body:         body,
+			contentType:  r.Header.Get("Content-Type"),
+			acl:          cannedACL(r),
+			lastModified: time.Now().UTC(),
+			metadata:     map[string]string{},
+		}
+		for k := range r.Header {
+			if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+				o.metadata[k] = r.Header.Get(k)
+			}
+		}
+		sum := md5.Sum(body)
+		o.etag = `"` + hex.EncodeToString(sum[:]) + `"`
+		b.objects[key] = o
+		w.Header().Set("ETag", o.etag)
+	case "CopyObject":
+		s.copyObject(w, r, b, key)
+	default:
+		o, ok := b.objects[key]
+		if !ok {
+			writeS3Error(w, r, errNoSuchKey(key))
+			return
+		}
+
+		switch operation {
+		case "GetObjectAcl":
+			writeXML(w, accessControlPolicy(o.acl))
+		case "PutObjectAcl":
+			o.acl = cannedACL(r)
+		case "DeleteObject":
+			delete(b.objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		case "GetObject", "HeadObject":
+			for k, v := range o.metadata {
+				w.Header().Set(k, v)
+			}
+			if o.contentType != "" {
+				w.Header().Set("Content-Type", o.contentType)
+			}
+			w.Header().Set("ETag", o.etag)
+			w.Header().Set("Last-Modified", o.lastModified.Format(http.TimeFormat))
+			w.Header().Set("Content-Length", strconv.Itoa(len(o.body)))
+			if operation == "GetObject" {
+				w.Write(o.body)
+			}
+		}
+	}
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter) {
+	type bucket struct {
+		Name         string `xml:"Name"`
+		CreationDate string `xml:"CreationDate"`
+	}
+	result := struct {
+		XMLName xml.Name  `xml:"ListAllMyBucketsResult"`
+		Xmlns   string    `xml:"xmlns,attr"`
+		Owner   ownerInfo `xml:"Owner"`
+		Buckets []*bucket `xml:"Buckets>Bucket"`
+	}{Xmlns: s3Namespace, Owner: owner}
+
+	for _, b := range s.buckets.find(nil) {
+		result.Buckets = append(result.Buckets, &bucket{Name: b.name, CreationDate: b.created.Format(time.RFC3339)})
+	}
+	writeXML(w, result)
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, b *bucketObject) {
+	type content struct {
+		Key          string `xml:"Key"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Size         int    `xml:"Size"`
+	}
+	prefix := r.URL.Query().Get("prefix")
+	result := struct {
+		XMLName  xml.Name   `xml:"ListBucketResult"`
+		Xmlns    string     `xml:"xmlns,attr"`
+		Name     string     `xml:"Name"`
+		Prefix   string     `xml:"Prefix"`
+		KeyCount int        `xml:"KeyCount"`
+		Contents []*content `xml:"Contents"`
+	}{Xmlns: s3Namespace, Name: b.name, Prefix: prefix}
+
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		o := b.objects[key]
+		result.Contents = append(result.Contents, &content{
+			Key:          key,
+			LastModified: o.lastModified.Format(time.RFC3339),
+			ETag:         o.etag,
+			Size:         len(o.body),
+		})
+	}
+	result.KeyCount = len(result.Contents)
+	writeXML(w, result)
+}
+
+func (s *Server) copyObject(w http.ResponseWriter, r *http.Request, b *bucketObject, key string) {
+	source, err := url.PathUnescape(strings.TrimPrefix(r.Header.Get("x-amz-copy-source"), "/"))
+	if err != nil {
+		writeS3Error(w, r, &s3Error{Code: "InvalidArgument", Message: err.Error(), statusCode: http.StatusBadRequest})
+		return
+	}
+	sourceBucket, sourceKey, _ := strings.Cut(source, "/")
+
+	src, ok := s.buckets.get(sourceBucket)
+	if !ok {
+		writeS3Error(w, r, errNoSuchBucket(sourceBucket))
+		return
+	}
+	o, ok := src.objects[sourceKey]
+	if !ok {
+		writeS3Error(w, r, errNoSuchKey(sourceKey))
+		return
+	}
+
+	copied := *o
+	copied.lastModified = time.Now().UTC()
+	copied.acl = cannedACL(r)
+	b.objects[key] = &copied
+
+	writeXML(w, struct {
+		XMLName      xml.Name `xml:"CopyObjectResult"`
+		ETag         string   `xml:"ETag"`
+		LastModified string   `xml:"LastModified"`
+	}{ETag: copied.etag, LastModified: copied.lastModified.Format(time.RFC3339)})
+}
+
+func cannedACL(r *http.Request) string {
+	if acl := r.Header.Get("x-amz-acl"); acl != "" {
+		return acl
+	}
+	return "private"
+}
+
+type grant struct {
+	Grantee struct {
+		XmlnsXsi string `xml:"xmlns:xsi,attr"`
+		Type     string `xml:"xsi:type,attr"`
+		ID       string `xml:"ID,omitempty"`
+		URI      string `xml:"URI,omitempty"`
+	} `xml:"Grantee"`
+	Permission string `xml:"Permission"`
+}
+
+func newGrant(granteeType, id, uri, permission string) *grant {
+	g := &grant{Permission: permission}
+	g.Grantee.XmlnsXsi = "http://www.w3.org/2001/XMLSchema-instance"
+	g.Grantee.Type = granteeType
+	g.Grantee.ID = id
+	g.Grantee.URI = uri
+	return g
+}
+
+// accessControlPolicy returns the grants of the canned ACL
+func accessControlPolicy(acl string) interface{} {
+	const allUsers = "http://acs.amazonaws.com/groups/global/AllUsers"
+	const authenticatedUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+
+	grants := []*grant{newGrant("CanonicalUser", s3OwnerID, "", "FULL_CONTROL")}
+	switch acl {
+	case "public-read":
+		grants = append(grants, newGrant("Group", "", allUsers, "READ"))
+	case "public-read-write":
+		grants = append(grants, newGrant("Group", "", allUsers, "READ"), newGrant("Group", "", allUsers, "WRITE"))
+	case "authenticated-read":
+		grants = append(grants, newGrant("Group", "", authenticatedUsers, "READ"))
+	}
+
+	return struct {
+		XMLName xml.Name  `xml:"AccessControlPolicy"`
+		Xmlns   string    `xml:"xmlns,attr"`
+		Owner   ownerInfo `xml:"Owner"`
+		Grants  []*grant  `xml:"AccessControlList>Grant"`
+	}{Xmlns: s3Namespace, Owner: owner, Grants: grants}
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write([]byte(xml.Header))
+	w.Write(b)
+}
+
+func writeS3Error(w http.ResponseWriter, r *http.Request, err *s3Error) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(err.statusCode)
+	if r.Method == http.MethodHead {
+		return
+	}
+	b, _ := xml.Marshal(err)
+	w.Write([]byte(xml.Header))
+	w.Write(b)
+}
@@ -0,0 +1,330 @@
+package mockapi_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/mockapi"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/loadbalancer"
+)
+
+func testClient(t *testing.T, server *mockapi.Server, maxRetries int) *conn.NcloudAPIClient {
+	config := conn.Config{
+		AccessKey:           "access",
+		SecretKey:           "secret",
+		Region:              "KR",
+		MaxRetries:          maxRetries,
+		RetryableErrorCodes: []string{"25013"},
+		Endpoints:           server.Endpoints(),
+	}
+
+	client, err := config.Client("public")
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	return client
+}
+
+func getVpcStatus(t *testing.T, client *conn.NcloudAPIClient, vpcNo *string) string {
+	resp, err := client.Vpc.V2Api.GetVpcDetail(&vpc.GetVpcDetailRequest{VpcNo: vpcNo})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if len(resp.VpcList) == 0 {
+		return ""
+	}
+	return ncloud.StringValue(resp.VpcList[0].VpcStatus.Code)
+}
+
+func TestServer_vpcLifecycle(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := testClient(t, server, 0)
+
+	resp, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{
+		VpcName:       ncloud.String("tf-vpc"),
+		Ipv4CidrBlock: ncloud.String("10.0.0.0/16"),
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	vpcNo := resp.VpcList[0].VpcNo
+
+	for _, expected := range []string{"INIT", "RUN", "RUN"} {
+		if status := getVpcStatus(t, client, vpcNo); status != expected {
+			t.Fatalf("Status expected %s but %s", expected, status)
+		}
+	}
+
+	acls, err := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: vpcNo})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if len(acls.NetworkAclList) != 1 || !ncloud.BoolValue(acls.NetworkAclList[0].IsDefault) {
+		t.Fatalf("Default network ACL expected but %v", acls.NetworkAclList)
+	}
+
+	acgs, err := client.Vserver.V2Api.GetAccessControlGroupList(&vserver.GetAccessControlGroupListRequest{VpcNo: vpcNo})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if len(acgs.AccessControlGroupList) != 1 || !ncloud.BoolValue(acgs.AccessControlGroupList[0].IsDefault) {
+		t.Fatalf("Default access control group expected but %v", acgs.AccessControlGroupList)
+	}
+
+	subnet, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		VpcNo:          vpcNo,
+		ZoneCode:       ncloud.String("KR-1"),
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		NetworkAclNo:   acls.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PUBLIC"),
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	if _, err := client.Vpc.V2Api.DeleteVpc(&vpc.DeleteVpcRequest{VpcNo: vpcNo}); err == nil {
+		t.Fatalf("Deleting VPC with a subnet expected error")
+	}
+
+	if _, err := client.Vpc.V2Api.DeleteSubnet(&vpc.DeleteSubnetRequest{SubnetNo: subnet.SubnetList[0].SubnetNo}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	// The subnet is gone once it has been read as terminating
+	subnets, err := client.Vpc.V2Api.GetSubnetList(&vpc.GetSubnetListRequest{VpcNo: vpcNo})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if len(subnets.SubnetList) != 1 || *subnets.SubnetList[0].SubnetStatus.Code != "TERMTING" {
+		t.Fatalf("Terminating subnet expected but %v", subnets.SubnetList)
+	}
+
+	if _, err := client.Vpc.V2Api.DeleteVpc(&vpc.DeleteVpcRequest{VpcNo: vpcNo}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	for _, expected := range []string{"TERMTING", ""} {
+		if status := getVpcStatus(t, client, vpcNo); status != expected {
+			t.Fatalf("Status expected %q but %q", expected, status)
+		}
+	}
+}
+
+func TestServer_injectError(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	server.InjectError("createVpc", mockapi.APIError{ReturnCode: "25013", ReturnMessage: "object in operation"}, 1)
+	if _, err := testClient(t, server, 1).Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{Ipv4CidrBlock: ncloud.String("10.0.0.0/16")}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if calls := server.Calls("createVpc"); calls != 2 {
+		t.Fatalf("Calls expected 2 but %d", calls)
+	}
+
+	server.InjectError("getVpcList", mockapi.APIError{ReturnCode: "800", ReturnMessage: "authority"}, 1)
+	_, err := testClient(t, server, 1).Vpc.V2Api.GetVpcList(&vpc.GetVpcListRequest{})
+	if err == nil {
+		t.Fatalf("Error expected")
+	}
+	errBody, err := conn.GetCommonErrorBody(err)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if errBody.ReturnCode != "800" {
+		t.Fatalf("Return code expected 800 but %s", errBody.ReturnCode)
+	}
+}
+
+func TestServer_serverLifecycle(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := testClient(t, server, 0)
+
+	if _, err := client.Vserver.V2Api.CreateLoginKey(&vserver.CreateLoginKeyRequest{KeyName: ncloud.String("tf-key")}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	vpcs, _ := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{Ipv4CidrBlock: ncloud.String("10.0.0.0/16")})
+	vpcNo := vpcs.VpcList[0].VpcNo
+	acls, _ := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: vpcNo})
+	subnets, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		VpcNo:          vpcNo,
+		ZoneCode:       ncloud.String("KR-2"),
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		NetworkAclNo:   acls.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PRIVATE"),
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	servers, err := client.Vserver.V2Api.CreateServerInstances(&vserver.CreateServerInstancesRequest{
+		VpcNo:        vpcNo,
+		SubnetNo:     subnets.SubnetList[0].SubnetNo,
+		LoginKeyName: ncloud.String("tf-key"),
+		NetworkInterfaceList: []*vserver.NetworkInterfaceParameter{
+			{NetworkInterfaceOrder: ncloud.Int32(0)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	instance := servers.ServerInstanceList[0]
+	if ncloud.StringValue(instance.ZoneCode) != "KR-2" || len(instance.NetworkInterfaceNoList) != 1 {
+		t.Fatalf("Server expected in KR-2 with a network interface but %v", instance)
+	}
+
+	expectStatuses := func(expected ...string) {
+		t.Helper()
+		for _, e := range expected {
+			resp, err := client.Vserver.V2Api.GetServerInstanceDetail(&vserver.GetServerInstanceDetailRequest{ServerInstanceNo: instance.ServerInstanceNo})
+			if err != nil {
+				t.Fatalf("Got error: %s", err)
+			}
+			status := ""
+			if len(resp.ServerInstanceList) > 0 {
+				status = ncloud.StringValue(resp.ServerInstanceList[0].ServerInstanceStatus.Code)
+			}
+			if status != e {
+				t.Fatalf("Status expected %q but %q", e, status)
+			}
+		}
+	}
+	noList := []*string{instance.ServerInstanceNo}
+
+	expectStatuses("INIT", "RUN")
+
+	if _, err := client.Vserver.V2Api.TerminateServerInstances(&vserver.TerminateServerInstancesRequest{ServerInstanceNoList: noList}); err == nil {
+		t.Fatalf("Terminating running server expected error")
+	}
+
+	if _, err := client.Vserver.V2Api.StopServerInstances(&vserver.StopServerInstancesRequest{ServerInstanceNoList: noList}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	expectStatuses("RUN", "NSTOP")
+
+	if _, err := client.Vserver.V2Api.StartServerInstances(&vserver.StartServerInstancesRequest{ServerInstanceNoList: noList}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	expectStatuses("NSTOP", "RUN")
+
+	client.Vserver.V2Api.StopServerInstances(&vserver.StopServerInstancesRequest{ServerInstanceNoList: noList})
+	expectStatuses("RUN", "NSTOP")
+	if _, err := client.Vserver.V2Api.TerminateServerInstances(&vserver.TerminateServerInstancesRequest{ServerInstanceNoList: noList}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	expectStatuses("NSTOP", "")
+}
+
+func TestServer_targetGroup(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := testClient(t, server, 0)
+
+	vpcs, _ := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{Ipv4CidrBlock: ncloud.String("10.0.0.0/16")})
+	resp, err := client.Vloadbalancer.V2Api.CreateTargetGroup(&vloadbalancer.CreateTargetGroupRequest{
+		VpcNo:                       vpcs.VpcList[0].VpcNo,
+		TargetGroupProtocolTypeCode: ncloud.String("HTTP"),
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	// The provider converts the response, which fails on missing codes
+	tg, err := loadbalancer.GetVpcLoadBalancerTargetGroup(&conn.ProviderConfig{Client: client, RegionCode: "KR", SupportVPC: true}, *resp.TargetGroupList[0].TargetGroupNo)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if ncloud.StringValue(tg.AlgorithmType) != "RR" || ncloud.StringValue(tg.HealthCheck[0].HealthCheckHttpMethodType) != "HEAD" {
+		t.Fatalf("Default algorithm and health check expected but %v", tg)
+	}
+
+	if _, err := client.Vloadbalancer.V2Api.DeleteTargetGroups(&vloadbalancer.DeleteTargetGroupsRequest{TargetGroupNoList: []*string{tg.TargetGroupNo}}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	list, _ := client.Vloadbalancer.V2Api.GetTargetGroupList(&vloadbalancer.GetTargetGroupListRequest{})
+	if len(list.TargetGroupList) != 0 {
+		t.Fatalf("No target group expected but %v", list.TargetGroupList)
+	}
+}
+
+func TestServer_objectStorage(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	client := testClient(t, server, 0).ObjectStorage
+	ctx := context.Background()
+
+	if _, err := client.CreateBucket(ctx, &s3.CreateBucketInput{Bucket: ncloud.String("tf-bucket")}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if _, err := client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      ncloud.String("tf-bucket"),
+		Key:         ncloud.String("dir/object.txt"),
+		Body:        bytes.NewReader([]byte("hello")),
+		ContentType: ncloud.String("text/plain"),
+		ACL:         types.ObjectCannedACLPublicRead,
+	}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if _, err := client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     ncloud.String("tf-bucket"),
+		Key:        ncloud.String("copied.txt"),
+		CopySource: ncloud.String("tf-bucket/dir/object.txt"),
+	}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	object, err := client.GetObject(ctx, &s3.GetObjectInput{Bucket: ncloud.String("tf-bucket"), Key: ncloud.String("copied.txt")})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	body, _ := io.ReadAll(object.Body)
+	object.Body.Close()
+	if string(body) != "hello" || ncloud.StringValue(object.ContentType) != "text/plain" {
+		t.Fatalf("Copied object expected but %s (%s)", body, ncloud.StringValue(object.ContentType))
+	}
+
+	acl, err := client.GetObjectAcl(ctx, &s3.GetObjectAclInput{Bucket: ncloud.String("tf-bucket"), Key: ncloud.String("dir/object.txt")})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if len(acl.Grants) != 2 || acl.Grants[1].Permission != types.PermissionRead || acl.Grants[1].Grantee.Type != types.TypeGroup {
+		t.Fatalf("Public read grants expected but %v", acl.Grants)
+	}
+
+	if _, err := client.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: ncloud.String("tf-bucket")}); err == nil {
+		t.Fatalf("Deleting bucket with objects expected error")
+	}
+
+	server.InjectError("HeadObject", mockapi.APIError{StatusCode: 500, ReturnCode: "InternalError", ReturnMessage: "injected"}, 1)
+	if _, err := client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: ncloud.String("tf-bucket"), Key: ncloud.String("copied.txt")}); err == nil {
+		t.Fatalf("Injected error expected")
+	}
+
+	for _, key := range []string{"dir/object.txt", "copied.txt"} {
+		if _, err := client.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: ncloud.String("tf-bucket"), Key: ncloud.String(key)}); err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+	}
+	if _, err := client.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: ncloud.String("tf-bucket")}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	buckets, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if len(buckets.Buckets) != 0 {
+		t.Fatalf("No bucket expected but %v", buckets.Buckets)
+	}
+}
@@ -0,0 +1,155 @@
+package mockapi
+
+// lifecycle is the status of an instance. Each read reports the current status and then moves on to the
+// next one, which simulates the asynchronous operations of the real API without waiting.
+type lifecycle struct {
+	statuses []string
+	// remove the instance once the last status has been read
+	remove bool
+}
+
+func newLifecycle(statuses ...string) *lifecycle {
+	return &lifecycle{statuses: statuses}
+}
+
+// removal returns the lifecycle of an instance being deleted, reported with the given statuses before it disappears
+func removal(statuses ...string) *lifecycle {
+	return &lifecycle{statuses: statuses, remove: true}
+}
+
+// gone returns whether the instance has been removed
+func (l *lifecycle) gone() bool {
+	return len(l.statuses) == 0
+}
+
+// current returns the status without moving on
+func (l *lifecycle) current() string {
+	if len(l.statuses) == 0 {
+		return ""
+	}
+	return l.statuses[0]
+}
+
+// read returns the status to report and moves on. It returns false if the instance is gone.
+func (l *lifecycle) read() (string, bool) {
+	if len(l.statuses) == 0 {
+		return "", false
+	}
+
+	status := l.statuses[0]
+	if len(l.statuses) > 1 || l.remove {
+		l.statuses = l.statuses[1:]
+	}
+	return status, true
+}
+
+// row is an instance with its status, which is nil for the kinds of instance without one
+type row[T any] struct {
+	value  *T
+	status *lifecycle
+}
+
+// table keeps the instances of a kind in creation order
+type table[T any] struct {
+	ids  []string
+	rows map[string]*row[T]
+	// setStatus renders the status into the instance on read
+	setStatus func(value *T, status string)
+}
+
+func newTable[T any](setStatus func(value *T, status string)) *table[T] {
+	return &table[T]{
+		rows:      map[string]*row[T]{},
+		setStatus: setStatus,
+	}
+}
+
+func (t *table[T]) put(id string, value *T, status *lifecycle) {
+	if _, ok := t.rows[id]; !ok {
+		t.ids = append(t.ids, id)
+	}
+	t.rows[id] = &row[T]{value: value, status: status}
+	if status != nil && t.setStatus != nil {
+		t.setStatus(value, status.current())
+	}
+}
+
+// get returns the instance without moving its status on
+func (t *table[T]) get(id string) (*T, bool) {
+	r, ok := t.rows[id]
+	if !ok {
+		return nil, false
+	}
+	return r.value, true
+}
+
+// setLifecycle replaces the status of the instance, e.g. when it is stopped or deleted
+func (t *table[T]) setLifecycle(id string, status *lifecycle) {
+	if r, ok := t.rows[id]; ok {
+		t.put(id, r.value, status)
+	}
+}
+
+// read returns the instance as reported by a read API, moving its status on
+func (t *table[T]) read(id string) (*T, bool) {
+	r, ok := t.rows[id]
+	if !ok {
+		return nil, false
+	}
+
+	if r.status != nil {
+		status, ok := r.status.read()
+		if !ok {
+			t.delete(id)
+			return nil, false
+		}
+		if t.setStatus != nil {
+			t.setStatus(r.value, status)
+		}
+		if r.status.gone() {
+			t.delete(id)
+		}
+	}
+
+	return r.value, true
+}
+
+// readAll reads the instances matching the filter in creation order
+func (t *table[T]) readAll(match func(value *T) bool) []*T {
+	ids := append([]string(nil), t.ids...)
+
+	values := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		if match != nil && !match(t.rows[id].value) {
+			continue
+		}
+		if value, ok := t.read(id); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func (t *table[T]) delete(id string) {
+	if _, ok := t.rows[id]; !ok {
+		return
+	}
+	delete(t.rows, id)
+	for i, v := range t.ids {
+		if v == id {
+			t.ids = append(t.ids[:i], t.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// find returns the instances matching the filter in creation order, without moving their statuses on
+func (t *table[T]) find(match func(value *T) bool) []*T {
+	values := make([]*T, 0, len(t.ids))
+	for _, id := range t.ids {
+		if value := t.rows[id].value; match == nil || match(value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
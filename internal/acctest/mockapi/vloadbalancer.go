@@ -0,0 +1,223 @@
+package mockapi
+
+import (
+	"net/url"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+)
+
+func vloadbalancerCode(code string) *vloadbalancer.CommonCode {
+	return &vloadbalancer.CommonCode{Code: ncloud.String(code), CodeName: ncloud.String(code)}
+}
+
+func newVloadbalancerTables(s *Server) {
+	s.targetGroups = newTable[vloadbalancer.TargetGroup](nil)
+}
+
+func (s *Server) vloadbalancerActions() map[string]actionFunc {
+	return map[string]actionFunc{
+		"createTargetGroup":                         s.createTargetGroup,
+		"getTargetGroupDetail":                      s.getTargetGroupDetail,
+		"getTargetGroupList":                        s.getTargetGroupList,
+		"changeTargetGroupConfiguration":            s.changeTargetGroupConfiguration,
+		"changeTargetGroupHealthCheckConfiguration": s.changeTargetGroupHealthCheckConfiguration,
+		"deleteTargetGroups":                        s.deleteTargetGroups,
+	}
+}
+
+func (s *Server) createTargetGroup(params url.Values) (interface{}, error) {
+	req := &vloadbalancer.CreateTargetGroupRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	vpcNo := ncloud.StringValue(req.VpcNo)
+	if _, ok := s.vpcs.get(vpcNo); !ok {
+		return nil, errNotFound("VPC", vpcNo)
+	}
+
+	protocol := ncloud.StringValue(req.TargetGroupProtocolTypeCode)
+	if !contains([]string{"TCP", "PROXY_TCP", "HTTP", "HTTPS"}, protocol) {
+		return nil, errInvalidParameter("invalid targetGroupProtocolTypeCode %s", protocol)
+	}
+
+	// Defaults of the real API
+	algorithmType, healthCheckProtocol, healthCheckHttpMethod := "RR", "TCP", ""
+	if protocol == "TCP" {
+		algorithmType = "MH"
+	}
+	if protocol == "HTTP" || protocol == "HTTPS" {
+		healthCheckProtocol, healthCheckHttpMethod = "HTTP", "HEAD"
+	}
+	if req.HealthCheckProtocolTypeCode != nil {
+		healthCheckProtocol = *req.HealthCheckProtocolTypeCode
+	}
+	if req.HealthCheckHttpMethodTypeCode != nil {
+		healthCheckHttpMethod = *req.HealthCheckHttpMethodTypeCode
+	}
+
+	targetType := ncloud.StringValue(req.TargetTypeCode)
+	if targetType == "" {
+		targetType = "VSVR"
+	}
+
+	no := s.nextID()
+	name := ncloud.StringValue(req.TargetGroupName)
+	if name == "" {
+		name = "tg-" + no
+	}
+
+	instance := &vloadbalancer.TargetGroup{
+		TargetGroupNo:             ncloud.String(no),
+		TargetGroupName:           ncloud.String(name),
+		TargetType:                vloadbalancerCode(targetType),
+		VpcNo:                     req.VpcNo,
+		TargetGroupProtocolType:   vloadbalancerCode(protocol),
+		TargetGroupPort:           int32OrDefault(req.TargetGroupPort, 80),
+		TargetGroupDescription:    req.TargetGroupDescription,
+		UseStickySession:          ncloud.Bool(false),
+		UseProxyProtocol:          ncloud.Bool(protocol == "PROXY_TCP"),
+		AlgorithmType:             vloadbalancerCode(algorithmType),
+		CreateDate:                ncloud.String(createDate),
+		RegionCode:                ncloud.String(s.regionCode),
+		HealthCheckProtocolType:   vloadbalancerCode(healthCheckProtocol),
+		HealthCheckPort:           int32OrDefault(req.HealthCheckPort, 80),
+		HealthCheckUrlPath:        req.HealthCheckUrlPath,
+		HealthCheckHttpMethodType: vloadbalancerCode(healthCheckHttpMethod),
+		HealthCheckCycle:          int32OrDefault(req.HealthCheckCycle, 30),
+		HealthCheckUpThreshold:    int32OrDefault(req.HealthCheckUpThreshold, 2),
+		HealthCheckDownThreshold:  int32OrDefault(req.HealthCheckDownThreshold, 2),
+		TargetNoList:              req.TargetNoList,
+	}
+	s.targetGroups.put(no, instance, nil)
+
+	return &vloadbalancer.CreateTargetGroupResponse{
+		TotalRows:       ncloud.Int32(1),
+		TargetGroupList: []*vloadbalancer.TargetGroup{instance},
+	}, nil
+}
+
+func (s *Server) getTargetGroupDetail(params url.Values) (interface{}, error) {
+	req := &vloadbalancer.GetTargetGroupDetailRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	resp := &vloadbalancer.GetTargetGroupDetailResponse{}
+	if instance, ok := s.targetGroups.read(ncloud.StringValue(req.TargetGroupNo)); ok {
+		resp.TargetGroupList = []*vloadbalancer.TargetGroup{instance}
+	}
+	resp.TotalRows = ncloud.Int32(int32(len(resp.TargetGroupList)))
+	return resp, nil
+}
+
+func (s *Server) getTargetGroupList(params url.Values) (interface{}, error) {
+	req := &vloadbalancer.GetTargetGroupListRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	list := s.targetGroups.readAll(func(v *vloadbalancer.TargetGroup) bool {
+		return matchList(req.TargetGroupNoList, v.TargetGroupNo) &&
+			match(req.TargetTypeCode, v.TargetType.Code) &&
+			match(req.VpcNo, v.VpcNo)
+	})
+	total := len(list)
+	list = paginate(list, req.PageNo, req.PageSize)
+
+	return &vloadbalancer.GetTargetGroupListResponse{TotalRows: ncloud.Int32(int32(total)), TargetGroupList: list}, nil
+}
+
+func (s *Server) changeTargetGroupConfiguration(params url.Values) (interface{}, error) {
+	req := &vloadbalancer.ChangeTargetGroupConfigurationRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	no := ncloud.StringValue(req.TargetGroupNo)
+	instance, ok := s.targetGroups.get(no)
+	if !ok {
+		return nil, errNotFound("Target group", no)
+	}
+
+	if req.AlgorithmTypeCode != nil {
+		instance.AlgorithmType = vloadbalancerCode(*req.AlgorithmTypeCode)
+	}
+	if req.UseProxyProtocol != nil {
+		instance.UseProxyProtocol = req.UseProxyProtocol
+	}
+	if req.UseStickySession != nil {
+		instance.UseStickySession = req.UseStickySession
+	}
+
+	return &vloadbalancer.ChangeTargetGroupConfigurationResponse{
+		TotalRows:       ncloud.Int32(1),
+		TargetGroupList: []*vloadbalancer.TargetGroup{instance},
+	}, nil
+}
+
+func (s *Server) changeTargetGroupHealthCheckConfiguration(params url.Values) (interface{}, error) {
+	req := &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	no := ncloud.StringValue(req.TargetGroupNo)
+	instance, ok := s.targetGroups.get(no)
+	if !ok {
+		return nil, errNotFound("Target group", no)
+	}
+
+	if req.HealthCheckCycle != nil {
+		instance.HealthCheckCycle = req.HealthCheckCycle
+	}
+	if req.HealthCheckDownThreshold != nil {
+		instance.HealthCheckDownThreshold = req.HealthCheckDownThreshold
+	}
+	if req.HealthCheckUpThreshold != nil {
+		instance.HealthCheckUpThreshold = req.HealthCheckUpThreshold
+	}
+	if req.HealthCheckPort != nil {
+		instance.HealthCheckPort = req.HealthCheckPort
+	}
+	if req.HealthCheckUrlPath != nil {
+		instance.HealthCheckUrlPath = req.HealthCheckUrlPath
+	}
+	if req.HealthCheckHttpMethodTypeCode != nil {
+		instance.HealthCheckHttpMethodType = vloadbalancerCode(*req.HealthCheckHttpMethodTypeCode)
+	}
+
+	return &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationResponse{
+		TotalRows:       ncloud.Int32(1),
+		TargetGroupList: []*vloadbalancer.TargetGroup{instance},
+	}, nil
+}
+
+func (s *Server) deleteTargetGroups(params url.Values) (interface{}, error) {
+	req := &vloadbalancer.DeleteTargetGroupsRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	var list []*vloadbalancer.TargetGroup
+	for _, no := range ncloud.StringListValue(req.TargetGroupNoList) {
+		instance, ok := s.targetGroups.get(no)
+		if !ok {
+			return nil, errNotFound("Target group", no)
+		}
+		list = append(list, instance)
+	}
+	for _, instance := range list {
+		s.targetGroups.delete(ncloud.StringValue(instance.TargetGroupNo))
+	}
+
+	return &vloadbalancer.DeleteTargetGroupsResponse{TotalRows: ncloud.Int32(int32(len(list))), TargetGroupList: list}, nil
+}
+
+func int32OrDefault(v *int32, defaultValue int32) *int32 {
+	if v == nil {
+		return ncloud.Int32(defaultValue)
+	}
+	return v
+}
@@ -0,0 +1,506 @@
+package mockapi
+
+import (
+	"net/url"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+)
+
+func vpcCode(code string) *vpc.CommonCode {
+	return &vpc.CommonCode{Code: ncloud.String(code), CodeName: ncloud.String(code)}
+}
+
+func newVpcTables(s *Server) {
+	s.vpcs = newTable(func(v *vpc.Vpc, status string) { v.VpcStatus = vpcCode(status) })
+	s.subnets = newTable(func(v *vpc.Subnet, status string) { v.SubnetStatus = vpcCode(status) })
+	s.networkAcls = newTable(func(v *vpc.NetworkAcl, status string) { v.NetworkAclStatus = vpcCode(status) })
+	s.routeTables = newTable(func(v *vpc.RouteTable, status string) { v.RouteTableStatus = vpcCode(status) })
+	s.vpcPeerings = newTable(func(v *vpc.VpcPeeringInstance, status string) { v.VpcPeeringInstanceStatus = vpcCode(status) })
+}
+
+func (s *Server) vpcActions() map[string]actionFunc {
+	return map[string]actionFunc{
+		"createVpc":                   s.createVpc,
+		"getVpcDetail":                s.getVpcDetail,
+		"getVpcList":                  s.getVpcList,
+		"deleteVpc":                   s.deleteVpc,
+		"createSubnet":                s.createSubnet,
+		"getSubnetDetail":             s.getSubnetDetail,
+		"getSubnetList":               s.getSubnetList,
+		"setSubnetNetworkAcl":         s.setSubnetNetworkAcl,
+		"deleteSubnet":                s.deleteSubnet,
+		"createNetworkAcl":            s.createNetworkAcl,
+		"getNetworkAclDetail":         s.getNetworkAclDetail,
+		"getNetworkAclList":           s.getNetworkAclList,
+		"deleteNetworkAcl":            s.deleteNetworkAcl,
+		"getRouteTableDetail":         s.getRouteTableDetail,
+		"getRouteTableList":           s.getRouteTableList,
+		"createVpcPeeringInstance":    s.createVpcPeeringInstance,
+		"getVpcPeeringInstanceDetail": s.getVpcPeeringInstanceDetail,
+		"acceptOrRejectVpcPeering":    s.acceptOrRejectVpcPeering,
+		"deleteVpcPeeringInstance":    s.deleteVpcPeeringInstance,
+	}
+}
+
+func (s *Server) createVpc(params url.Values) (interface{}, error) {
+	req := &vpc.CreateVpcRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+	if req.Ipv4CidrBlock == nil {
+		return nil, errInvalidParameter("ipv4CidrBlock is required")
+	}
+
+	no := s.nextID()
+	name := ncloud.StringValue(req.VpcName)
+	if name == "" {
+		name = "vpc-" + no
+	}
+
+	instance := &vpc.Vpc{
+		VpcNo:         ncloud.String(no),
+		VpcName:       ncloud.String(name),
+		Ipv4CidrBlock: req.Ipv4CidrBlock,
+		RegionCode:    ncloud.String(s.regionCode),
+		CreateDate:    ncloud.String(createDate),
+	}
+	s.vpcs.put(no, instance, newLifecycle("INIT", "RUN"))
+
+	// Every VPC comes with its default network ACL, access control group and route tables
+	aclNo := s.nextID()
+	s.networkAcls.put(aclNo, &vpc.NetworkAcl{
+		NetworkAclNo:   ncloud.String(aclNo),
+		NetworkAclName: ncloud.String(name + "-default-network-acl"),
+		VpcNo:          ncloud.String(no),
+		IsDefault:      ncloud.Bool(true),
+		CreateDate:     ncloud.String(createDate),
+	}, newLifecycle("RUN"))
+	s.putDefaultAccessControlGroup(no, name)
+	for _, subnetType := range []string{"PUBLIC", "PRIVATE"} {
+		routeTableNo := s.nextID()
+		s.routeTables.put(routeTableNo, &vpc.RouteTable{
+			RouteTableNo:        ncloud.String(routeTableNo),
+			RouteTableName:      ncloud.String(name + "-default-" + lowerFirst(subnetType) + "-table"),
+			RegionCode:          ncloud.String(s.regionCode),
+			VpcNo:               ncloud.String(no),
+			SupportedSubnetType: vpcCode(subnetType),
+			IsDefault:           ncloud.Bool(true),
+		}, newLifecycle("RUN"))
+	}
+
+	return &vpc.CreateVpcResponse{TotalRows: ncloud.Int32(1), VpcList: []*vpc.Vpc{instance}}, nil
+}
+
+func (s *Server) getVpcDetail(params url.Values) (interface{}, error) {
+	req := &vpc.GetVpcDetailRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.GetVpcDetailResponse{}
+	if instance, ok := s.vpcs.read(ncloud.StringValue(req.VpcNo)); ok {
+		resp.VpcList = []*vpc.Vpc{instance}
+	}
+	resp.TotalRows = ncloud.Int32(int32(len(resp.VpcList)))
+	return resp, nil
+}
+
+func (s *Server) getVpcList(params url.Values) (interface{}, error) {
+	req := &vpc.GetVpcListRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	list := s.vpcs.readAll(func(v *vpc.Vpc) bool {
+		return matchList(req.VpcNoList, v.VpcNo) && match(req.VpcName, v.VpcName)
+	})
+	list = filter(list, func(v *vpc.Vpc) bool { return match(req.VpcStatusCode, v.VpcStatus.Code) })
+
+	return &vpc.GetVpcListResponse{TotalRows: ncloud.Int32(int32(len(list))), VpcList: list}, nil
+}
+
+func (s *Server) deleteVpc(params url.Values) (interface{}, error) {
+	req := &vpc.DeleteVpcRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	no := ncloud.StringValue(req.VpcNo)
+	instance, ok := s.vpcs.get(no)
+	if !ok {
+		return nil, errNotFound("VPC", no)
+	}
+
+	for _, subnet := range s.subnets.find(nil) {
+		if ncloud.StringValue(subnet.VpcNo) == no {
+			return nil, errInvalidParameter("VPC (%s) still has the subnet (%s)", no, ncloud.StringValue(subnet.SubnetNo))
+		}
+	}
+
+	s.vpcs.setLifecycle(no, removal("TERMTING"))
+	s.deleteVpcDefaults(no)
+
+	return &vpc.DeleteVpcResponse{TotalRows: ncloud.Int32(1), VpcList: []*vpc.Vpc{instance}}, nil
+}
+
+func (s *Server) deleteVpcDefaults(vpcNo string) {
+	for _, acl := range s.networkAcls.find(nil) {
+		if ncloud.StringValue(acl.VpcNo) == vpcNo {
+			s.networkAcls.delete(ncloud.StringValue(acl.NetworkAclNo))
+		}
+	}
+	for _, routeTable := range s.routeTables.find(nil) {
+		if ncloud.StringValue(routeTable.VpcNo) == vpcNo {
+			s.routeTables.delete(ncloud.StringValue(routeTable.RouteTableNo))
+		}
+	}
+	s.deleteVpcAccessControlGroups(vpcNo)
+}
+
+func (s *Server) createSubnet(params url.Values) (interface{}, error) {
+	req := &vpc.CreateSubnetRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	vpcNo := ncloud.StringValue(req.VpcNo)
+	if _, ok := s.vpcs.get(vpcNo); !ok {
+		return nil, errNotFound("VPC", vpcNo)
+	}
+	aclNo := ncloud.StringValue(req.NetworkAclNo)
+	if _, ok := s.networkAcls.get(aclNo); !ok {
+		return nil, errNotFound("Network ACL", aclNo)
+	}
+	if !contains(s.zoneCodes, ncloud.StringValue(req.ZoneCode)) {
+		return nil, errInvalidParameter("invalid zoneCode %s", ncloud.StringValue(req.ZoneCode))
+	}
+
+	no := s.nextID()
+	name := ncloud.StringValue(req.SubnetName)
+	if name == "" {
+		name = "subnet-" + no
+	}
+	usageType := ncloud.StringValue(req.UsageTypeCode)
+	if usageType == "" {
+		usageType = "GEN"
+	}
+
+	instance := &vpc.Subnet{
+		SubnetNo:     ncloud.String(no),
+		VpcNo:        req.VpcNo,
+		ZoneCode:     req.ZoneCode,
+		SubnetName:   ncloud.String(name),
+		Subnet:       req.Subnet,
+		CreateDate:   ncloud.String(createDate),
+		SubnetType:   vpcCode(ncloud.StringValue(req.SubnetTypeCode)),
+		UsageType:    vpcCode(usageType),
+		NetworkAclNo: req.NetworkAclNo,
+	}
+	s.subnets.put(no, instance, newLifecycle("INIT", "RUN"))
+
+	return &vpc.CreateSubnetResponse{TotalRows: ncloud.Int32(1), SubnetList: []*vpc.Subnet{instance}}, nil
+}
+
+func (s *Server) getSubnetDetail(params url.Values) (interface{}, error) {
+	req := &vpc.GetSubnetDetailRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.GetSubnetDetailResponse{}
+	if instance, ok := s.subnets.read(ncloud.StringValue(req.SubnetNo)); ok {
+		resp.SubnetList = []*vpc.Subnet{instance}
+	}
+	resp.TotalRows = ncloud.Int32(int32(len(resp.SubnetList)))
+	return resp, nil
+}
+
+func (s *Server) getSubnetList(params url.Values) (interface{}, error) {
+	req := &vpc.GetSubnetListRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	list := s.subnets.readAll(func(v *vpc.Subnet) bool {
+		return matchList(req.SubnetNoList, v.SubnetNo) &&
+			match(req.SubnetName, v.SubnetName) &&
+			match(req.Subnet, v.Subnet) &&
+			match(req.SubnetTypeCode, v.SubnetType.Code) &&
+			match(req.UsageTypeCode, v.UsageType.Code) &&
+			match(req.NetworkAclNo, v.NetworkAclNo) &&
+			match(req.VpcNo, v.VpcNo) &&
+			match(req.ZoneCode, v.ZoneCode)
+	})
+	list = filter(list, func(v *vpc.Subnet) bool { return match(req.SubnetStatusCode, v.SubnetStatus.Code) })
+	total := len(list)
+	list = paginate(list, req.PageNo, req.PageSize)
+
+	return &vpc.GetSubnetListResponse{TotalRows: ncloud.Int32(int32(total)), SubnetList: list}, nil
+}
+
+func (s *Server) setSubnetNetworkAcl(params url.Values) (interface{}, error) {
+	req := &vpc.SetSubnetNetworkAclRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	subnetNo := ncloud.StringValue(req.SubnetNo)
+	subnet, ok := s.subnets.get(subnetNo)
+	if !ok {
+		return nil, errNotFound("Subnet", subnetNo)
+	}
+	aclNo := ncloud.StringValue(req.NetworkAclNo)
+	acl, ok := s.networkAcls.get(aclNo)
+	if !ok {
+		return nil, errNotFound("Network ACL", aclNo)
+	}
+
+	subnet.NetworkAclNo = req.NetworkAclNo
+	s.networkAcls.setLifecycle(aclNo, newLifecycle("SET", "RUN"))
+
+	return &vpc.SetSubnetNetworkAclResponse{TotalRows: ncloud.Int32(1), NetworkAclList: []*vpc.NetworkAcl{acl}}, nil
+}
+
+func (s *Server) deleteSubnet(params url.Values) (interface{}, error) {
+	req := &vpc.DeleteSubnetRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	no := ncloud.StringValue(req.SubnetNo)
+	instance, ok := s.subnets.get(no)
+	if !ok {
+		return nil, errNotFound("Subnet", no)
+	}
+	s.subnets.setLifecycle(no, removal("TERMTING"))
+
+	return &vpc.DeleteSubnetResponse{TotalRows: ncloud.Int32(1), SubnetList: []*vpc.Subnet{instance}}, nil
+}
+
+func (s *Server) createNetworkAcl(params url.Values) (interface{}, error) {
+	req := &vpc.CreateNetworkAclRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	vpcNo := ncloud.StringValue(req.VpcNo)
+	if _, ok := s.vpcs.get(vpcNo); !ok {
+		return nil, errNotFound("VPC", vpcNo)
+	}
+
+	no := s.nextID()
+	name := ncloud.StringValue(req.NetworkAclName)
+	if name == "" {
+		name = "nacl-" + no
+	}
+
+	instance := &vpc.NetworkAcl{
+		NetworkAclNo:          ncloud.String(no),
+		NetworkAclName:        ncloud.String(name),
+		VpcNo:                 req.VpcNo,
+		NetworkAclDescription: req.NetworkAclDescription,
+		CreateDate:            ncloud.String(createDate),
+		IsDefault:             ncloud.Bool(false),
+	}
+	s.networkAcls.put(no, instance, newLifecycle("INIT", "RUN"))
+
+	return &vpc.CreateNetworkAclResponse{TotalRows: ncloud.Int32(1), NetworkAclList: []*vpc.NetworkAcl{instance}}, nil
+}
+
+func (s *Server) getNetworkAclDetail(params url.Values) (interface{}, error) {
+	req := &vpc.GetNetworkAclDetailRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.GetNetworkAclDetailResponse{}
+	if instance, ok := s.networkAcls.read(ncloud.StringValue(req.NetworkAclNo)); ok {
+		resp.NetworkAclList = []*vpc.NetworkAcl{instance}
+	}
+	resp.TotalRows = ncloud.Int32(int32(len(resp.NetworkAclList)))
+	return resp, nil
+}
+
+func (s *Server) getNetworkAclList(params url.Values) (interface{}, error) {
+	req := &vpc.GetNetworkAclListRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	list := s.networkAcls.readAll(func(v *vpc.NetworkAcl) bool {
+		return matchList(req.NetworkAclNoList, v.NetworkAclNo) &&
+			match(req.NetworkAclName, v.NetworkAclName) &&
+			match(req.VpcNo, v.VpcNo)
+	})
+	list = filter(list, func(v *vpc.NetworkAcl) bool { return match(req.NetworkAclStatusCode, v.NetworkAclStatus.Code) })
+	total := len(list)
+	list = paginate(list, req.PageNo, req.PageSize)
+
+	return &vpc.GetNetworkAclListResponse{TotalRows: ncloud.Int32(int32(total)), NetworkAclList: list}, nil
+}
+
+func (s *Server) deleteNetworkAcl(params url.Values) (interface{}, error) {
+	req := &vpc.DeleteNetworkAclRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	no := ncloud.StringValue(req.NetworkAclNo)
+	instance, ok := s.networkAcls.get(no)
+	if !ok {
+		return nil, errNotFound("Network ACL", no)
+	}
+	if ncloud.BoolValue(instance.IsDefault) {
+		return nil, errInvalidParameter("the default network ACL (%s) can't be deleted", no)
+	}
+	s.networkAcls.setLifecycle(no, removal("TERMTING"))
+
+	return &vpc.DeleteNetworkAclResponse{TotalRows: ncloud.Int32(1), NetworkAclList: []*vpc.NetworkAcl{instance}}, nil
+}
+
+func (s *Server) getRouteTableDetail(params url.Values) (interface{}, error) {
+	req := &vpc.GetRouteTableDetailRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.GetRouteTableDetailResponse{}
+	if instance, ok := s.routeTables.read(ncloud.StringValue(req.RouteTableNo)); ok {
+		resp.RouteTableList = []*vpc.RouteTable{instance}
+	}
+	resp.TotalRows = ncloud.Int32(int32(len(resp.RouteTableList)))
+	return resp, nil
+}
+
+func (s *Server) getRouteTableList(params url.Values) (interface{}, error) {
+	req := &vpc.GetRouteTableListRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	list := s.routeTables.readAll(func(v *vpc.RouteTable) bool {
+		return matchList(req.RouteTableNoList, v.RouteTableNo) &&
+			match(req.RouteTableName, v.RouteTableName) &&
+			match(req.SupportedSubnetTypeCode, v.SupportedSubnetType.Code) &&
+			match(req.VpcNo, v.VpcNo)
+	})
+	total := len(list)
+	list = paginate(list, req.PageNo, req.PageSize)
+
+	return &vpc.GetRouteTableListResponse{TotalRows: ncloud.Int32(int32(total)), RouteTableList: list}, nil
+}
+
+// mockLoginId is the login ID of the account of the server
+const mockLoginId = "mockapi"
+
+// createVpcPeeringInstance requests a VPC peering. A peering to the VPC of another login ID is between accounts,
+// which stays INIT until the target account accepts it.
+func (s *Server) createVpcPeeringInstance(params url.Values) (interface{}, error) {
+	req := &vpc.CreateVpcPeeringInstanceRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	sourceVpcNo := ncloud.StringValue(req.SourceVpcNo)
+	sourceVpc, ok := s.vpcs.get(sourceVpcNo)
+	if !ok {
+		return nil, errNotFound("VPC", sourceVpcNo)
+	}
+
+	no := s.nextID()
+	name := ncloud.StringValue(req.VpcPeeringName)
+	if name == "" {
+		name = "peering-" + no
+	}
+
+	instance := &vpc.VpcPeeringInstance{
+		VpcPeeringInstanceNo:   ncloud.String(no),
+		VpcPeeringName:         ncloud.String(name),
+		VpcPeeringDescription:  req.VpcPeeringDescription,
+		RegionCode:             ncloud.String(s.regionCode),
+		CreateDate:             ncloud.String(createDate),
+		SourceVpcNo:            sourceVpc.VpcNo,
+		SourceVpcName:          sourceVpc.VpcName,
+		SourceVpcIpv4CidrBlock: sourceVpc.Ipv4CidrBlock,
+		SourceVpcLoginId:       ncloud.String(mockLoginId),
+		TargetVpcNo:            req.TargetVpcNo,
+		TargetVpcName:          req.TargetVpcName,
+		HasReverseVpcPeering:   ncloud.Bool(false),
+		IsBetweenAccounts:      ncloud.Bool(false),
+	}
+
+	if loginId := ncloud.StringValue(req.TargetVpcLoginId); loginId != "" && loginId != mockLoginId {
+		instance.TargetVpcLoginId = req.TargetVpcLoginId
+		instance.IsBetweenAccounts = ncloud.Bool(true)
+		s.vpcPeerings.put(no, instance, newLifecycle("INIT"))
+	} else {
+		targetVpcNo := ncloud.StringValue(req.TargetVpcNo)
+		targetVpc, ok := s.vpcs.get(targetVpcNo)
+		if !ok {
+			return nil, errNotFound("VPC", targetVpcNo)
+		}
+		instance.TargetVpcName = targetVpc.VpcName
+		instance.TargetVpcIpv4CidrBlock = targetVpc.Ipv4CidrBlock
+		instance.TargetVpcLoginId = ncloud.String(mockLoginId)
+		s.vpcPeerings.put(no, instance, newLifecycle("INIT", "CREATING", "RUN"))
+	}
+
+	return &vpc.CreateVpcPeeringInstanceResponse{TotalRows: ncloud.Int32(1), VpcPeeringInstanceList: []*vpc.VpcPeeringInstance{instance}}, nil
+}
+
+func (s *Server) getVpcPeeringInstanceDetail(params url.Values) (interface{}, error) {
+	req := &vpc.GetVpcPeeringInstanceDetailRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	resp := &vpc.GetVpcPeeringInstanceDetailResponse{}
+	if instance, ok := s.vpcPeerings.read(ncloud.StringValue(req.VpcPeeringInstanceNo)); ok {
+		resp.VpcPeeringInstanceList = []*vpc.VpcPeeringInstance{instance}
+	}
+	resp.TotalRows = ncloud.Int32(int32(len(resp.VpcPeeringInstanceList)))
+	return resp, nil
+}
+
+// acceptOrRejectVpcPeering accepts or rejects a VPC peering between accounts waiting for acceptance
+func (s *Server) acceptOrRejectVpcPeering(params url.Values) (interface{}, error) {
+	req := &vpc.AcceptOrRejectVpcPeeringRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	no := ncloud.StringValue(req.VpcPeeringInstanceNo)
+	instance, ok := s.vpcPeerings.get(no)
+	if !ok {
+		return nil, errNotFound("VPC Peering", no)
+	}
+
+	if !ncloud.BoolValue(instance.IsBetweenAccounts) || ncloud.StringValue(instance.VpcPeeringInstanceStatus.Code) != "INIT" {
+		return nil, errInvalidParameter("VPC Peering (%s) is not waiting for acceptance", no)
+	}
+
+	if ncloud.BoolValue(req.IsAccept) {
+		s.vpcPeerings.setLifecycle(no, newLifecycle("CREATING", "RUN"))
+	} else {
+		s.vpcPeerings.setLifecycle(no, removal("TERMTING"))
+	}
+
+	return &vpc.AcceptOrRejectVpcPeeringResponse{TotalRows: ncloud.Int32(1), VpcPeeringInstanceList: []*vpc.VpcPeeringInstance{instance}}, nil
+}
+
+func (s *Server) deleteVpcPeeringInstance(params url.Values) (interface{}, error) {
+	req := &vpc.DeleteVpcPeeringInstanceRequest{}
+	if err := decodeParams(params, req); err != nil {
+		return nil, err
+	}
+
+	no := ncloud.StringValue(req.VpcPeeringInstanceNo)
+	instance, ok := s.vpcPeerings.get(no)
+	if !ok {
+		return nil, errNotFound("VPC Peering", no)
+	}
+	s.vpcPeerings.setLifecycle(no, removal("TERMTING"))
+
+	return &vpc.DeleteVpcPeeringInstanceResponse{TotalRows: ncloud.Int32(1), VpcPeeringInstanceList: []*vpc.VpcPeeringInstance{instance}}, nil
+}
@@ -1,14 +1,9 @@
 package common
 
 import (
-	"encoding/json"
-	"fmt"
-	"log"
 	"regexp"
 
-	"strings"
-
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
 
 const (
@@ -34,6 +29,18 @@ const (
 	ApiErrorASGIsUsingPolicyOrLaunchConfigurationOnVpc = "1250700"
 )
 
+// DefaultRetryableErrorCodes are the API error codes retried by the provider-wide retry policy
+// when `retryable_error_codes` is not set in the provider block.
+var DefaultRetryableErrorCodes = []string{
+	ApiErrorObjectInOperation,
+	ApiErrorPortForwardingObjectInOperation,
+	ApiErrorServerObjectInOperation,
+	ApiErrorServerObjectInOperation2,
+	ApiErrorAcgCantChangeSameTime,
+	ApiErrorNetworkAclRuleChangeIngRules,
+	ApiErrorASGScalingIsActive,
+}
+
 const (
 	InstanceStatusInit        = "INIT"
 	InstanceStatusCreate      = "CREATING"
@@ -63,30 +70,7 @@ type CommonCode struct {
 }
 
 // CommonError response error body
-type CommonError struct {
-	ReturnCode    string
-	ReturnMessage string
-}
-
-func LogErrorResponse(tag string, err error, args interface{}) {
-	param, _ := json.Marshal(args)
-	log.Printf("[ERROR] %s error params=%s, err=%s", tag, param, err)
-}
-
-func LogCommonRequest(tag string, args interface{}) {
-	param, _ := json.Marshal(args)
-	log.Printf("[INFO] %s params=%s", tag, param)
-}
-
-func LogResponse(tag string, args interface{}) {
-	resp, _ := json.Marshal(args)
-	log.Printf("[INFO] %s response=%s", tag, resp)
-}
-
-func LogCommonResponse(tag string, commonResponse *CommonResponse, logs ...string) {
-	result := fmt.Sprintf("RequestID: %s, ReturnCode: %s, ReturnMessage: %s", ncloud.StringValue(commonResponse.RequestId), ncloud.StringValue(commonResponse.ReturnCode), ncloud.StringValue(commonResponse.ReturnMessage))
-	log.Printf("[INFO] %s success response=%s %s", tag, result, strings.Join(logs, " "))
-}
+type CommonError = conn.CommonError
 
 func ContainsInStringList(str string, s []string) bool {
 	for _, v := range s {
@@ -2,9 +2,7 @@ package common
 
 import (
 	"encoding/json"
-	"fmt"
 	"reflect"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -57,26 +55,7 @@ func GetCommonResponse(i interface{}) *CommonResponse {
 
 // GetCommonErrorBody parse common error message
 func GetCommonErrorBody(err error) (*CommonError, error) {
-	sa := strings.Split(err.Error(), "Body: ")
-	var errMsg string
-
-	if len(sa) != 2 {
-		return nil, fmt.Errorf("error body is incorrect: %s", err)
-	}
-
-	errMsg = sa[1]
-
-	var m map[string]interface{}
-	if err := json.Unmarshal([]byte(errMsg), &m); err != nil {
-		return nil, err
-	}
-
-	e := m["responseError"].(map[string]interface{})
-
-	return &CommonError{
-		ReturnCode:    e["returnCode"].(string),
-		ReturnMessage: e["returnMessage"].(string),
-	}, nil
+	return conn.GetCommonErrorBody(err)
 }
 
 func GetRegion(i interface{}) *conn.Region {
@@ -0,0 +1,271 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// LogSubsystemAPI is the tflog subsystem of API request/response logs.
+// Its level can be set separately with the TF_LOG_PROVIDER_NCLOUD_API environment variable.
+const LogSubsystemAPI = "api"
+
+// RedactedLogValue replaces the value of sensitive fields in logs
+const RedactedLogValue = "***"
+
+var (
+	sensitiveLogFieldsMu sync.RWMutex
+
+	// sensitiveLogFields are masked in request/response logs. They are matched with the JSON field name
+	// case-insensitively and ignoring underscores, so `user_password` also matches `userPassword`.
+	sensitiveLogFields = map[string]struct{}{
+		"clientkey": {},
+	}
+
+	// sensitiveLogFieldPatterns mask every field whose name contains one of them,
+	// e.g. `cloudMysqlUserPassword`, `kdcPassword` or `secretKey`
+	sensitiveLogFieldPatterns = []string{
+		"password",
+		"secret",
+		"privatekey",
+	}
+)
+
+// newLogContext returns a context for the logging helpers without a context parameter, e.g. LogCommonRequest.
+// It carries a new provider root logger set up like the one of the plugin server, so the logs hold no
+// fields of another request or provider instance.
+func newLogContext() context.Context {
+	return tfsdklog.NewRootProviderLogger(context.Background(),
+		tfsdklog.WithStderrFromInit(),
+		tfsdklog.WithLogName("ncloud"),
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "ncloud"),
+	)
+}
+
+func normalizeLogField(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// AddSensitiveLogFields adds field names to be masked in request/response logs
+func AddSensitiveLogFields(names ...string) {
+	sensitiveLogFieldsMu.Lock()
+	defer sensitiveLogFieldsMu.Unlock()
+
+	for _, name := range names {
+		sensitiveLogFields[normalizeLogField(name)] = struct{}{}
+	}
+}
+
+// AddSensitiveLogFieldsFromSchema adds the attributes marked as `Sensitive` in the schema, including nested blocks,
+// to the fields masked in request/response logs
+func AddSensitiveLogFieldsFromSchema(s map[string]*schema.Schema) {
+	for name, attr := range s {
+		if attr.Sensitive {
+			AddSensitiveLogFields(name)
+		}
+		if r, ok := attr.Elem.(*schema.Resource); ok {
+			AddSensitiveLogFieldsFromSchema(r.Schema)
+		}
+	}
+}
+
+// AddSensitiveLogFieldsFromFrameworkSchema adds the attributes marked as `Sensitive` in the framework resource schema,
+// including nested attributes and blocks, to the fields masked in request/response logs
+func AddSensitiveLogFieldsFromFrameworkSchema(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) {
+	for name, attr := range attributes {
+		if attr.IsSensitive() {
+			AddSensitiveLogFields(name)
+		}
+
+		switch a := attr.(type) {
+		case fwschema.SingleNestedAttribute:
+			AddSensitiveLogFieldsFromFrameworkSchema(a.Attributes, nil)
+		case fwschema.ListNestedAttribute:
+			AddSensitiveLogFieldsFromFrameworkSchema(a.NestedObject.Attributes, nil)
+		case fwschema.SetNestedAttribute:
+			AddSensitiveLogFieldsFromFrameworkSchema(a.NestedObject.Attributes, nil)
+		case fwschema.MapNestedAttribute:
+			AddSensitiveLogFieldsFromFrameworkSchema(a.NestedObject.Attributes, nil)
+		}
+	}
+
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case fwschema.SingleNestedBlock:
+			AddSensitiveLogFieldsFromFrameworkSchema(b.Attributes, b.Blocks)
+		case fwschema.ListNestedBlock:
+			AddSensitiveLogFieldsFromFrameworkSchema(b.NestedObject.Attributes, b.NestedObject.Blocks)
+		case fwschema.SetNestedBlock:
+			AddSensitiveLogFieldsFromFrameworkSchema(b.NestedObject.Attributes, b.NestedObject.Blocks)
+		}
+	}
+}
+
+func isSensitiveLogField(name string, tagged map[string]struct{}) bool {
+	name = normalizeLogField(name)
+	if _, ok := tagged[name]; ok {
+		return true
+	}
+
+	for _, pattern := range sensitiveLogFieldPatterns {
+		if strings.Contains(name, pattern) {
+			return true
+		}
+	}
+
+	sensitiveLogFieldsMu.RLock()
+	defer sensitiveLogFieldsMu.RUnlock()
+
+	_, ok := sensitiveLogFields[name]
+	return ok
+}
+
+// RedactLogValue returns the JSON encoding of value with sensitive fields masked.
+// Besides the registered field names, struct fields tagged with `sensitive:"true"` are masked.
+func RedactLogValue(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return string(b)
+	}
+
+	tagged := map[string]struct{}{}
+	collectSensitiveTaggedFields(reflect.TypeOf(value), tagged, map[reflect.Type]bool{})
+
+	return string(MarshalUnchecked(redactLogValue(v, tagged)))
+}
+
+func redactLogValue(v interface{}, tagged map[string]struct{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if isSensitiveLogField(k, tagged) && e != nil {
+				v[k] = RedactedLogValue
+				continue
+			}
+			v[k] = redactLogValue(e, tagged)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactLogValue(e, tagged)
+		}
+	}
+	return v
+}
+
+func collectSensitiveTaggedFields(t reflect.Type, tagged map[string]struct{}, visited map[reflect.Type]bool) {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || visited[t] {
+		return
+	}
+	visited[t] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Tag.Get("sensitive") == "true" {
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "" || name == "-" {
+				name = f.Name
+			}
+			tagged[normalizeLogField(name)] = struct{}{}
+		}
+		collectSensitiveTaggedFields(f.Type, tagged, visited)
+	}
+}
+
+// apiLogContext adds the api subsystem to ctx. skip is the number of the logging helpers between the tflog call
+// and the caller, so that the logs point at the caller.
+func apiLogContext(ctx context.Context, skip int) context.Context {
+	return tflog.NewSubsystem(ctx, LogSubsystemAPI,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_NCLOUD", LogSubsystemAPI),
+		tflog.WithAdditionalLocationOffset(skip),
+	)
+}
+
+// LogErrorResponse logs API error with the request params to the api subsystem of a new provider logger.
+// Use LogErrorResponseContext when context is available.
+func LogErrorResponse(tag string, err error, args interface{}) {
+	logErrorResponse(newLogContext(), 2, tag, err, args)
+}
+
+// LogCommonRequest logs API request params to the api subsystem of a new provider logger.
+// Use LogCommonRequestContext when context is available.
+func LogCommonRequest(tag string, args interface{}) {
+	logCommonRequest(newLogContext(), 2, tag, args)
+}
+
+// LogResponse logs API response to the api subsystem of a new provider logger.
+// Use LogResponseContext when context is available.
+func LogResponse(tag string, args interface{}) {
+	logResponse(newLogContext(), 2, tag, args)
+}
+
+// LogCommonResponse logs API common response to the api subsystem of a new provider logger.
+// Use LogCommonResponseContext when context is available.
+func LogCommonResponse(tag string, commonResponse *CommonResponse, logs ...string) {
+	logCommonResponse(newLogContext(), 2, tag, commonResponse, logs...)
+}
+
+// LogErrorResponseContext logs API error with the request params to the api subsystem
+func LogErrorResponseContext(ctx context.Context, tag string, err error, args interface{}) {
+	logErrorResponse(ctx, 2, tag, err, args)
+}
+
+// LogCommonRequestContext logs API request params to the api subsystem
+func LogCommonRequestContext(ctx context.Context, tag string, args interface{}) {
+	logCommonRequest(ctx, 2, tag, args)
+}
+
+// LogResponseContext logs API response to the api subsystem
+func LogResponseContext(ctx context.Context, tag string, args interface{}) {
+	logResponse(ctx, 2, tag, args)
+}
+
+// LogCommonResponseContext logs API common response to the api subsystem
+func LogCommonResponseContext(ctx context.Context, tag string, commonResponse *CommonResponse, logs ...string) {
+	logCommonResponse(ctx, 2, tag, commonResponse, logs...)
+}
+
+func logErrorResponse(ctx context.Context, skip int, tag string, err error, args interface{}) {
+	tflog.SubsystemError(apiLogContext(ctx, skip), LogSubsystemAPI, tag+" error", map[string]interface{}{
+		"params": RedactLogValue(args),
+		"error":  err.Error(),
+	})
+}
+
+func logCommonRequest(ctx context.Context, skip int, tag string, args interface{}) {
+	tflog.SubsystemInfo(apiLogContext(ctx, skip), LogSubsystemAPI, tag, map[string]interface{}{
+		"params": RedactLogValue(args),
+	})
+}
+
+func logResponse(ctx context.Context, skip int, tag string, args interface{}) {
+	tflog.SubsystemInfo(apiLogContext(ctx, skip), LogSubsystemAPI, tag+" response", map[string]interface{}{
+		"response": RedactLogValue(args),
+	})
+}
+
+func logCommonResponse(ctx context.Context, skip int, tag string, commonResponse *CommonResponse, logs ...string) {
+	tflog.SubsystemInfo(apiLogContext(ctx, skip), LogSubsystemAPI, tag+" success", map[string]interface{}{
+		"response": strings.TrimSpace(commonResponseString(commonResponse) + " " + strings.Join(logs, " ")),
+	})
+}
+
+func commonResponseString(commonResponse *CommonResponse) string {
+	return fmt.Sprintf("RequestID: %s, ReturnCode: %s, ReturnMessage: %s", ncloud.StringValue(commonResponse.RequestId), ncloud.StringValue(commonResponse.ReturnCode), ncloud.StringValue(commonResponse.ReturnMessage))
+}
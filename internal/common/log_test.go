@@ -0,0 +1,94 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testLogRequest struct {
+	ServiceName     *string `json:"serviceName,omitempty"`
+	UserPassword    *string `json:"userPassword,omitempty"`
+	InitScriptToken *string `json:"initScriptToken,omitempty" sensitive:"true"`
+	CustomSecret    *string `json:"customSecret,omitempty"`
+	Users           []*testLogUser
+}
+
+type testLogUser struct {
+	Name     *string `json:"name,omitempty"`
+	Password *string `json:"password,omitempty"`
+}
+
+func TestRedactLogValue(t *testing.T) {
+	req := &testLogRequest{
+		ServiceName:     ncloud.String("tf-test"),
+		UserPassword:    ncloud.String("p@ssw0rd"),
+		InitScriptToken: ncloud.String("token"),
+		Users: []*testLogUser{
+			{Name: ncloud.String("admin"), Password: ncloud.String("p@ssw0rd")},
+		},
+	}
+
+	expected := `{"Users":[{"name":"admin","password":"***"}],"initScriptToken":"***","serviceName":"tf-test","userPassword":"***"}`
+	if actual := RedactLogValue(req); actual != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, actual)
+	}
+}
+
+func TestAddSensitiveLogFieldsFromSchema(t *testing.T) {
+	AddSensitiveLogFieldsFromSchema(map[string]*schema.Schema{
+		"custom_secret": {
+			Type:      schema.TypeString,
+			Sensitive: true,
+		},
+	})
+
+	req := &testLogRequest{
+		ServiceName:  ncloud.String("tf-test"),
+		CustomSecret: ncloud.String("secret"),
+	}
+
+	expected := `{"Users":null,"customSecret":"***","serviceName":"tf-test"}`
+	if actual := RedactLogValue(req); actual != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, actual)
+	}
+}
+
+func TestRedactLogValue_passwordSuffix(t *testing.T) {
+	req := &vmysql.CreateCloudMysqlInstanceRequest{
+		CloudMysqlServiceName:  ncloud.String("tf-test"),
+		CloudMysqlUserName:     ncloud.String("admin"),
+		CloudMysqlUserPassword: ncloud.String("p@ssw0rd"),
+	}
+
+	actual := RedactLogValue(req)
+	if strings.Contains(actual, "p@ssw0rd") {
+		t.Fatalf("Password is not masked: %s", actual)
+	}
+
+	if !strings.Contains(actual, `"cloudMysqlUserPassword":"***"`) || !strings.Contains(actual, `"cloudMysqlUserName":"admin"`) {
+		t.Fatalf("Unexpected log value: %s", actual)
+	}
+}
+
+func TestAddSensitiveLogFieldsFromFrameworkSchema(t *testing.T) {
+	AddSensitiveLogFieldsFromFrameworkSchema(map[string]fwschema.Attribute{
+		"users": fwschema.ListNestedAttribute{
+			NestedObject: fwschema.NestedAttributeObject{
+				Attributes: map[string]fwschema.Attribute{
+					"init_script_token": fwschema.StringAttribute{
+						Sensitive: true,
+					},
+				},
+			},
+		},
+	}, nil)
+
+	if !isSensitiveLogField("initScriptToken", nil) {
+		t.Fatal("Nested sensitive attribute is not registered")
+	}
+}
@@ -4,20 +4,24 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
-func NewS3Client(region string, api *ncloud.APIKey, site, endpointFromEnv string) *s3.Client {
+// NewS3Client returns the Object Storage client. wrapTransport wraps the transport built by the AWS SDK,
+// which honors settings such as AWS_CA_BUNDLE.
+func NewS3Client(region string, api *ncloud.APIKey, site, endpointOverride string, wrapTransport func(http.RoundTripper) http.RoundTripper, maxRetries int) *s3.Client {
 	var endpoint string
-	if endpointFromEnv != "" {
-		endpoint = endpointFromEnv
+	if endpointOverride != "" {
+		endpoint = endpointOverride
 	} else {
-		endpoint = genEndpointWithCode(region, site)
+		endpoint = GenEndpointWithCode(region, site)
 	}
 
 	if api.AccessKey == "" || api.SecretKey == "" {
@@ -27,22 +31,30 @@ func NewS3Client(region string, api *ncloud.APIKey, site, endpointFromEnv string
 	cfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(api.AccessKey, api.SecretKey, "")),
 		config.WithRegion(region),
+		config.WithRetryMaxAttempts(maxRetries+1),
 	)
 
 	if err != nil {
 		log.Fatalf("unable to load SDK config, %v", err)
 	}
 
+	var transport http.RoundTripper = http.DefaultTransport
+	if c, ok := cfg.HTTPClient.(*awshttp.BuildableClient); ok {
+		transport = c.GetTransport()
+	}
+
 	newClient := s3.NewFromConfig(cfg, func(o *s3.Options) {
 		o.BaseEndpoint = ncloud.String(endpoint)
+		o.HTTPClient = &http.Client{Transport: wrapTransport(transport)}
 	})
 
 	return newClient
 }
 
+// GenEndpointWithCode returns the Object Storage endpoint of the region and site
 // API docs: https://api.ncloud-docs.com/docs/platform-region-getregionlist
 // Common object storage docs; https://api.ncloud-docs.com/docs/storage-objectstorage
-func genEndpointWithCode(region, site string) string {
+func GenEndpointWithCode(region, site string) string {
 	var s3Endpoint string
 	switch site {
 	case "gov":
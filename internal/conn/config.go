@@ -2,6 +2,9 @@ package conn
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vhadoop"
@@ -47,9 +50,43 @@ const DefaultStopTimeout = 5 * time.Minute
 var version = ""
 
 type Config struct {
-	AccessKey string
-	SecretKey string
-	Region    string
+	AccessKey             string
+	SecretKey             string
+	Region                string
+	MaxRetries            int
+	RetryableErrorCodes   []string
+	MaxRequestsPerSecond  int
+	MaxConcurrentRequests int
+	Endpoints             map[string]string
+}
+
+// EndpointServices are the API clients whose endpoint can be overridden by the provider `endpoints` block
+var EndpointServices = []string{
+	"autoscaling",
+	"cdn",
+	"clouddb",
+	"loadbalancer",
+	"object_storage",
+	"server",
+	"sourcebuild",
+	"sourcecommit",
+	"sourcepipeline",
+	"vautoscaling",
+	"vcdss",
+	"vhadoop",
+	"vloadbalancer",
+	"vmongodb",
+	"vmssql",
+	"vmysql",
+	"vnas",
+	"vnks",
+	"vpc",
+	"vpostgresql",
+	"vredis",
+	"vserver",
+	"vses",
+	"vsourcedeploy",
+	"vsourcepipeline",
 }
 
 type NcloudAPIClient struct {
@@ -80,38 +117,53 @@ type NcloudAPIClient struct {
 	ObjectStorage   *s3.Client
 }
 
-func (c *Config) Client(site, endpoint string) (*NcloudAPIClient, error) {
+func (c *Config) Client(site string) (*NcloudAPIClient, error) {
 	apiKey := &ncloud.APIKey{
 		AccessKey: c.AccessKey,
 		SecretKey: c.SecretKey,
 	}
 
+	// Every client shares one limiter, and each retry attempt is limited as well
+	transport := newRateLimitTransport(http.DefaultTransport, c.MaxRequestsPerSecond, c.MaxConcurrentRequests)
+	httpClient := &http.Client{
+		Transport: newRetryTransport(transport, c.MaxRetries, c.RetryableErrorCodes),
+	}
+
+	configure := func(service string, cfg *ncloud.Configuration) *ncloud.Configuration {
+		cfg.HTTPClient = httpClient
+		cfg.BasePath = siteBasePath(cfg.BasePath, site)
+		if endpoint := c.Endpoints[service]; endpoint != "" {
+			cfg.BasePath = strings.TrimSuffix(endpoint, "/")
+		}
+		return cfg
+	}
+
 	return &NcloudAPIClient{
-		Server:          server.NewAPIClient(server.NewConfiguration(apiKey)),
-		Autoscaling:     autoscaling.NewAPIClient(autoscaling.NewConfiguration(apiKey)),
-		Loadbalancer:    loadbalancer.NewAPIClient(loadbalancer.NewConfiguration(apiKey)),
-		Cdn:             cdn.NewAPIClient(cdn.NewConfiguration(apiKey)),
-		Clouddb:         clouddb.NewAPIClient(clouddb.NewConfiguration(apiKey)),
-		Vpc:             vpc.NewAPIClient(vpc.NewConfiguration(apiKey)),
-		Vserver:         vserver.NewAPIClient(vserver.NewConfiguration(apiKey)),
-		Vnas:            vnas.NewAPIClient(vnas.NewConfiguration(apiKey)),
-		Vautoscaling:    vautoscaling.NewAPIClient(vautoscaling.NewConfiguration(apiKey)),
-		Vloadbalancer:   vloadbalancer.NewAPIClient(vloadbalancer.NewConfiguration(apiKey)),
-		Vnks:            vnks.NewAPIClient(vnks.NewConfigurationWithUserAgent(c.Region, fmt.Sprintf("Ncloud Terraform Provider/%s", version), apiKey)),
-		Sourcecommit:    sourcecommit.NewAPIClient(sourcecommit.NewConfiguration(c.Region, apiKey)),
-		Sourcebuild:     sourcebuild.NewAPIClient((sourcebuild.NewConfiguration(c.Region, apiKey))),
-		Sourcepipeline:  sourcepipeline.NewAPIClient(sourcepipeline.NewConfiguration(c.Region, apiKey)),
-		Vsourcedeploy:   vsourcedeploy.NewAPIClient(vsourcedeploy.NewConfiguration(c.Region, apiKey)),
-		Vsourcepipeline: vsourcepipeline.NewAPIClient(vsourcepipeline.NewConfiguration(c.Region, apiKey)),
-		Vses:            vses2.NewAPIClient(vses2.NewConfiguration(c.Region, apiKey)),
-		Vcdss:           vcdss.NewAPIClient(vcdss.NewConfiguration(c.Region, apiKey)),
-		Vmysql:          vmysql.NewAPIClient(vmysql.NewConfiguration(apiKey)),
-		Vmongodb:        vmongodb.NewAPIClient(vmongodb.NewConfiguration(apiKey)),
-		Vmssql:          vmssql.NewAPIClient(vmssql.NewConfiguration(apiKey)),
-		Vpostgresql:     vpostgresql.NewAPIClient(vpostgresql.NewConfiguration(apiKey)),
-		Vhadoop:         vhadoop.NewAPIClient(vhadoop.NewConfiguration(apiKey)),
-		Vredis:          vredis.NewAPIClient(vredis.NewConfiguration(apiKey)),
-		ObjectStorage:   NewS3Client(c.Region, apiKey, site, endpoint),
+		Server:          server.NewAPIClient(configure("server", server.NewConfiguration(apiKey))),
+		Autoscaling:     autoscaling.NewAPIClient(configure("autoscaling", autoscaling.NewConfiguration(apiKey))),
+		Loadbalancer:    loadbalancer.NewAPIClient(configure("loadbalancer", loadbalancer.NewConfiguration(apiKey))),
+		Cdn:             cdn.NewAPIClient(configure("cdn", cdn.NewConfiguration(apiKey))),
+		Clouddb:         clouddb.NewAPIClient(configure("clouddb", clouddb.NewConfiguration(apiKey))),
+		Vpc:             vpc.NewAPIClient(configure("vpc", vpc.NewConfiguration(apiKey))),
+		Vserver:         vserver.NewAPIClient(configure("vserver", vserver.NewConfiguration(apiKey))),
+		Vnas:            vnas.NewAPIClient(configure("vnas", vnas.NewConfiguration(apiKey))),
+		Vautoscaling:    vautoscaling.NewAPIClient(configure("vautoscaling", vautoscaling.NewConfiguration(apiKey))),
+		Vloadbalancer:   vloadbalancer.NewAPIClient(configure("vloadbalancer", vloadbalancer.NewConfiguration(apiKey))),
+		Vnks:            vnks.NewAPIClient(configure("vnks", vnks.NewConfigurationWithUserAgent(c.Region, fmt.Sprintf("Ncloud Terraform Provider/%s", version), apiKey))),
+		Sourcecommit:    sourcecommit.NewAPIClient(configure("sourcecommit", sourcecommit.NewConfiguration(c.Region, apiKey))),
+		Sourcebuild:     sourcebuild.NewAPIClient(configure("sourcebuild", sourcebuild.NewConfiguration(c.Region, apiKey))),
+		Sourcepipeline:  sourcepipeline.NewAPIClient(configure("sourcepipeline", sourcepipeline.NewConfiguration(c.Region, apiKey))),
+		Vsourcedeploy:   vsourcedeploy.NewAPIClient(configure("vsourcedeploy", vsourcedeploy.NewConfiguration(c.Region, apiKey))),
+		Vsourcepipeline: vsourcepipeline.NewAPIClient(configure("vsourcepipeline", vsourcepipeline.NewConfiguration(c.Region, apiKey))),
+		Vses:            vses2.NewAPIClient(configure("vses", vses2.NewConfiguration(c.Region, apiKey))),
+		Vcdss:           vcdss.NewAPIClient(configure("vcdss", vcdss.NewConfiguration(c.Region, apiKey))),
+		Vmysql:          vmysql.NewAPIClient(configure("vmysql", vmysql.NewConfiguration(apiKey))),
+		Vmongodb:        vmongodb.NewAPIClient(configure("vmongodb", vmongodb.NewConfiguration(apiKey))),
+		Vmssql:          vmssql.NewAPIClient(configure("vmssql", vmssql.NewConfiguration(apiKey))),
+		Vpostgresql:     vpostgresql.NewAPIClient(configure("vpostgresql", vpostgresql.NewConfiguration(apiKey))),
+		Vhadoop:         vhadoop.NewAPIClient(configure("vhadoop", vhadoop.NewConfiguration(apiKey))),
+		Vredis:          vredis.NewAPIClient(configure("vredis", vredis.NewConfiguration(apiKey))),
+		ObjectStorage:   NewS3Client(c.Region, apiKey, site, c.Endpoints["object_storage"], transport.withBase, c.MaxRetries),
 	}, nil
 }
 
@@ -121,4 +173,20 @@ type ProviderConfig struct {
 	RegionCode string
 	RegionNo   string
 	Client     *NcloudAPIClient
+	Regions    map[string]Region
+
+	zoneNoByCode sync.Map
+}
+
+// GetCachedZoneNo returns zone no cached by GetZoneNoByCode, or empty string if not cached
+func (c *ProviderConfig) GetCachedZoneNo(code string) string {
+	if zoneNo, ok := c.zoneNoByCode.Load(code); ok {
+		return zoneNo.(string)
+	}
+	return ""
+}
+
+// SetCachedZoneNo caches zone no of the provider region and site
+func (c *ProviderConfig) SetCachedZoneNo(code, zoneNo string) {
+	c.zoneNoByCode.Store(code, zoneNo)
 }
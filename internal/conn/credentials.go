@@ -0,0 +1,107 @@
+package conn
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile used when `profile` is not set
+const DefaultProfile = "DEFAULT"
+
+// SharedCredentials is a profile of the shared credentials file
+type SharedCredentials struct {
+	AccessKey string
+	SecretKey string
+	Region    string
+	Site      string
+}
+
+// DefaultSharedCredentialsFile returns the path of the credentials file written by the ncloud CLI
+func DefaultSharedCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".ncloud", "configure"), nil
+}
+
+// LoadSharedCredentials reads the profile from an INI-style credentials file like the one written by the ncloud CLI:
+//
+//	[DEFAULT]
+//	ncloud_access_key_id = ...
+//	ncloud_secret_access_key = ...
+//	region = KR
+//	site = public
+func LoadSharedCredentials(filename, profile string) (*SharedCredentials, error) {
+	if strings.HasPrefix(filename, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		filename = filepath.Join(home, filename[2:])
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error opening shared credentials file: %s", err)
+	}
+	defer file.Close()
+
+	var credentials *SharedCredentials
+
+	// Keys before the first section belong to the default profile, as in the legacy ncloud CLI format
+	section := DefaultProfile
+
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == profile && credentials == nil {
+				credentials = &SharedCredentials{}
+			}
+			continue
+		}
+
+		if section != profile {
+			continue
+		}
+		if credentials == nil {
+			credentials = &SharedCredentials{}
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("error parsing shared credentials file %s:%d: expected key = value", filename, lineNo)
+		}
+
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "ncloud_access_key_id":
+			credentials.AccessKey = value
+		case "ncloud_secret_access_key":
+			credentials.SecretKey = value
+		case "region", "ncloud_region":
+			credentials.Region = value
+		case "site", "ncloud_site":
+			credentials.Site = value
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading shared credentials file: %s", err)
+	}
+
+	if credentials == nil {
+		return nil, fmt.Errorf("profile `%s` not found in shared credentials file %s", profile, filename)
+	}
+
+	return credentials, nil
+}
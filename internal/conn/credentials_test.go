@@ -0,0 +1,76 @@
+package conn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSharedCredentials = `[DEFAULT]
+ncloud_access_key_id = defaultAccessKey
+ncloud_secret_access_key = defaultSecretKey
+
+# government sub-account
+[gov]
+ncloud_access_key_id = govAccessKey
+ncloud_secret_access_key = govSecretKey
+region = KR
+site = gov
+`
+
+func writeTestSharedCredentials(t *testing.T, content string) string {
+	filename := filepath.Join(t.TempDir(), "configure")
+	if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	return filename
+}
+
+func TestLoadSharedCredentials_profile(t *testing.T) {
+	filename := writeTestSharedCredentials(t, testSharedCredentials)
+
+	credentials, err := LoadSharedCredentials(filename, "gov")
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	expected := SharedCredentials{AccessKey: "govAccessKey", SecretKey: "govSecretKey", Region: "KR", Site: "gov"}
+	if *credentials != expected {
+		t.Fatalf("Expected: %+v, Actual: %+v", expected, *credentials)
+	}
+}
+
+func TestLoadSharedCredentials_defaultProfile(t *testing.T) {
+	filename := writeTestSharedCredentials(t, testSharedCredentials)
+
+	credentials, err := LoadSharedCredentials(filename, DefaultProfile)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	expected := SharedCredentials{AccessKey: "defaultAccessKey", SecretKey: "defaultSecretKey"}
+	if *credentials != expected {
+		t.Fatalf("Expected: %+v, Actual: %+v", expected, *credentials)
+	}
+}
+
+func TestLoadSharedCredentials_legacyFormat(t *testing.T) {
+	filename := writeTestSharedCredentials(t, "ncloud_access_key_id = accessKey\nncloud_secret_access_key = secretKey\n")
+
+	credentials, err := LoadSharedCredentials(filename, DefaultProfile)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	if credentials.AccessKey != "accessKey" || credentials.SecretKey != "secretKey" {
+		t.Fatalf("Unexpected credentials: %+v", *credentials)
+	}
+}
+
+func TestLoadSharedCredentials_profileNotFound(t *testing.T) {
+	filename := writeTestSharedCredentials(t, testSharedCredentials)
+
+	if _, err := LoadSharedCredentials(filename, "fin"); err == nil {
+		t.Fatalf("Expected error for missing profile")
+	}
+}
@@ -0,0 +1,45 @@
+package conn
+
+import (
+	"net/url"
+	"strings"
+)
+
+const publicAPIGatewayDomain = ".apigw.ntruss.com"
+
+// finPrefixedAPIGateways are the API gateways whose host is prefixed with "fin-" on the fin site
+var finPrefixedAPIGateways = []string{
+	"ncloud",
+	"clouddatastreamingservice",
+	"vpcsearchengine",
+}
+
+// siteBasePath converts the default (public site) base path of an API client to the base path of the site.
+// It's the same conversion the SDK does with the NCLOUD_API_GW environment variable, without touching the
+// process environment, so that provider instances of different sites can be used at the same time.
+func siteBasePath(basePath, site string) string {
+	if site != "gov" && site != "fin" {
+		return basePath
+	}
+
+	u, err := url.Parse(basePath)
+	if err != nil || !strings.HasSuffix(u.Host, publicAPIGatewayDomain) {
+		return basePath
+	}
+
+	gateway := strings.TrimSuffix(u.Host, publicAPIGatewayDomain)
+	switch site {
+	case "gov":
+		u.Host = gateway + ".apigw.gov-ntruss.com"
+	case "fin":
+		for _, g := range finPrefixedAPIGateways {
+			if gateway == g {
+				gateway = "fin-" + gateway
+				break
+			}
+		}
+		u.Host = gateway + ".apigw.fin-ntruss.com"
+	}
+
+	return u.String()
+}
@@ -0,0 +1,29 @@
+package conn
+
+import (
+	"testing"
+)
+
+func TestSiteBasePath(t *testing.T) {
+	cases := []struct {
+		basePath string
+		site     string
+		expected string
+	}{
+		{"https://ncloud.apigw.ntruss.com/vserver/v2", "", "https://ncloud.apigw.ntruss.com/vserver/v2"},
+		{"https://ncloud.apigw.ntruss.com/vserver/v2", "public", "https://ncloud.apigw.ntruss.com/vserver/v2"},
+		{"https://ncloud.apigw.ntruss.com/vserver/v2", "gov", "https://ncloud.apigw.gov-ntruss.com/vserver/v2"},
+		{"https://ncloud.apigw.ntruss.com/vserver/v2", "fin", "https://fin-ncloud.apigw.fin-ntruss.com/vserver/v2"},
+		{"https://nks.apigw.ntruss.com/vnks/v2", "gov", "https://nks.apigw.gov-ntruss.com/vnks/v2"},
+		{"https://nks.apigw.ntruss.com/nks/v2", "fin", "https://nks.apigw.fin-ntruss.com/nks/v2"},
+		{"https://sourcecommit.apigw.ntruss.com/api/v1", "fin", "https://sourcecommit.apigw.fin-ntruss.com/api/v1"},
+		{"https://vpcsearchengine.apigw.ntruss.com/api/v2", "fin", "https://fin-vpcsearchengine.apigw.fin-ntruss.com/api/v2"},
+		{"https://ncloud-gw.example.com/vserver/v2", "gov", "https://ncloud-gw.example.com/vserver/v2"},
+	}
+
+	for _, tc := range cases {
+		if actual := siteBasePath(tc.basePath, tc.site); actual != tc.expected {
+			t.Fatalf("siteBasePath(%s, %s) expected %s but %s", tc.basePath, tc.site, tc.expected, actual)
+		}
+	}
+}
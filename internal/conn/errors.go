@@ -0,0 +1,43 @@
+package conn
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CommonError response error body
+type CommonError struct {
+	ReturnCode    string
+	ReturnMessage string
+}
+
+// GetCommonErrorBody parse common error message
+func GetCommonErrorBody(err error) (*CommonError, error) {
+	sa := strings.Split(err.Error(), "Body: ")
+	var errMsg string
+
+	if len(sa) != 2 {
+		return nil, fmt.Errorf("error body is incorrect: %s", err)
+	}
+
+	errMsg = sa[1]
+
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(errMsg), &m); err != nil {
+		return nil, err
+	}
+
+	e, ok := m["responseError"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("error body has no responseError: %s", errMsg)
+	}
+
+	returnCode, _ := e["returnCode"].(string)
+	returnMessage, _ := e["returnMessage"].(string)
+
+	return &CommonError{
+		ReturnCode:    returnCode,
+		ReturnMessage: returnMessage,
+	}, nil
+}
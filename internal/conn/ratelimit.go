@@ -0,0 +1,69 @@
+package conn
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// Default client-side limits when `max_requests_per_second` / `max_concurrent_requests` are not set
+const (
+	DefaultMaxRequestsPerSecond  = 10
+	DefaultMaxConcurrentRequests = 10
+)
+
+// rateLimitTransport limits the request rate with a token bucket and caps the number of in-flight requests.
+// A single instance is shared by every API client of a provider instance.
+type rateLimitTransport struct {
+	base     http.RoundTripper
+	limiter  *rate.Limiter
+	inFlight chan struct{}
+}
+
+// newRateLimitTransport returns base wrapped with the given limits. A limit lower than 1 means unlimited.
+func newRateLimitTransport(base http.RoundTripper, requestsPerSecond, concurrentRequests int) *rateLimitTransport {
+	t := &rateLimitTransport{
+		base: base,
+	}
+
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond)
+	}
+	if concurrentRequests > 0 {
+		t.inFlight = make(chan struct{}, concurrentRequests)
+	}
+
+	return t
+}
+
+// withBase returns a transport over another base which shares the limits with t
+func (t *rateLimitTransport) withBase(base http.RoundTripper) http.RoundTripper {
+	return &rateLimitTransport{
+		base:     base,
+		limiter:  t.limiter,
+		inFlight: t.inFlight,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// The slot is released once the response headers arrive, so streamed object
+	// bodies can't hold it and block other requests.
+	if t.inFlight != nil {
+		select {
+		case t.inFlight <- struct{}{}:
+			defer func() { <-t.inFlight }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
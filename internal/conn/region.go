@@ -2,7 +2,6 @@ package conn
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 
@@ -16,11 +15,27 @@ type Region struct {
 	RegionName *string `json:"regionName,omitempty"`
 }
 
-var regionCacheByCode = make(map[string]Region)
+// classicRegionNos is the region number of each region code in the classic environment, as returned by getRegionList.
+// The VPC environment identifies regions by code only.
+var classicRegionNos = map[string]string{
+	"KR":   "1",
+	"USWN": "2",
+	"HK":   "3",
+	"SGN":  "5",
+	"JPN":  "7",
+	"DEN":  "8",
+}
+
+// ClassicRegionNo returns the well-known region number of the region code in the classic environment,
+// for use where the region list can't be fetched from the API.
+func ClassicRegionNo(code string) (string, bool) {
+	regionNo, ok := classicRegionNos[code]
+	return regionNo, ok
+}
 
-func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
+func ParseRegionNoParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if regionCode, regionCodeOk := d.GetOk("region"); regionCodeOk {
-		regionNo := GetRegionNoByCode(regionCode.(string))
+		regionNo := GetRegionNoByCode(config, regionCode.(string))
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string))
 		}
@@ -28,8 +43,8 @@ func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	}
 
 	// provider region
-	if regionCode := os.Getenv("NCLOUD_REGION"); regionCode != "" {
-		regionNo := GetRegionNoByCode(regionCode)
+	if regionCode := config.RegionCode; regionCode != "" {
+		regionNo := GetRegionNoByCode(config, regionCode)
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
 		}
@@ -39,8 +54,8 @@ func ParseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	return nil, nil
 }
 
-func GetRegionNoByCode(code string) *string {
-	if region, ok := regionCacheByCode[code]; ok {
+func GetRegionNoByCode(config *ProviderConfig, code string) *string {
+	if region, ok := config.Regions[code]; ok {
 		return region.RegionNo
 	}
 	return nil
@@ -64,29 +79,31 @@ func GetRegionByCode(client *NcloudAPIClient, code string) (*server.Region, erro
 	return filteredRegion, nil
 }
 
-func SetRegionCache(client *NcloudAPIClient, supportVPC bool) error {
+func SetRegionCache(config *ProviderConfig) error {
 	var regionList []*Region
 	var err error
-	if supportVPC {
-		regionList, err = getVpcRegionList(client)
+	if config.SupportVPC {
+		regionList, err = getVpcRegionList(config.Client)
 	} else {
-		regionList, err = getClassicRegionList(client)
+		regionList, err = getClassicRegionList(config.Client)
 	}
 
 	if err != nil {
 		return err
 	}
 
+	config.Regions = make(map[string]Region, len(regionList))
+
 	for _, r := range regionList {
 		region := Region{
 			RegionCode: r.RegionCode,
 			RegionName: r.RegionName,
 		}
-		if !supportVPC {
+		if !config.SupportVPC {
 			region.RegionNo = r.RegionNo
 		}
 
-		regionCacheByCode[*region.RegionCode] = region
+		config.Regions[*region.RegionCode] = region
 	}
 
 	return nil
@@ -128,7 +145,7 @@ func getVpcRegionList(client *NcloudAPIClient) ([]*Region, error) {
 	return regionList, nil
 }
 
-func IsValidRegionCode(code string) bool {
-	_, ok := regionCacheByCode[code]
+func IsValidRegionCode(config *ProviderConfig, code string) bool {
+	_, ok := config.Regions[code]
 	return ok
 }
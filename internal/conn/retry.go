@@ -0,0 +1,148 @@
+package conn
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// DefaultMaxRetries is the number of retries for a retryable API error when `max_retries` is not set
+const DefaultMaxRetries = 5
+
+const (
+	minRetryDelay = 1 * time.Second
+	maxRetryDelay = 30 * time.Second
+)
+
+// retryTransport retries NCLOUD API requests that fail with a transient error,
+// waiting with exponential backoff and jitter between attempts.
+type retryTransport struct {
+	base           http.RoundTripper
+	maxRetries     int
+	retryableCodes map[string]struct{}
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, retryableCodes []string) http.RoundTripper {
+	codes := make(map[string]struct{}, len(retryableCodes))
+	for _, code := range retryableCodes {
+		codes[code] = struct{}{}
+	}
+
+	return &retryTransport{
+		base:           base,
+		maxRetries:     maxRetries,
+		retryableCodes: codes,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+
+		reason := t.retryReason(req, resp)
+		if reason == "" || attempt >= t.maxRetries {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		delay := retryDelay(attempt)
+		log.Printf("[DEBUG] %s %s failed with %s, retrying in %s (%d/%d)", req.Method, req.URL.Path, reason, delay, attempt+1, t.maxRetries)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryReason returns why the response should be retried, or empty string if it should not.
+func (t *retryTransport) retryReason(req *http.Request, resp *http.Response) string {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return resp.Status
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		// The backend may have processed the request before the gateway failed, retrying e.g. createServerInstances could create duplicates
+		if isReadOnlyAction(req) {
+			return resp.Status
+		}
+		return ""
+	}
+
+	if resp.StatusCode < http.StatusBadRequest || len(t.retryableCodes) == 0 {
+		return ""
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	// Same format as the error reported by the SDK clients
+	errBody, err := GetCommonErrorBody(fmt.Errorf("Status: %v, Body: %s", resp.Status, body))
+	if err != nil {
+		return ""
+	}
+
+	if _, ok := t.retryableCodes[errBody.ReturnCode]; ok {
+		return fmt.Sprintf("error code %s", errBody.ReturnCode)
+	}
+
+	return ""
+}
+
+// isReadOnlyAction reports whether the API action of the request only reads, e.g. getServerInstanceList
+func isReadOnlyAction(req *http.Request) bool {
+	action := path.Base(req.URL.Path)
+	return strings.HasPrefix(action, "get") || strings.HasPrefix(action, "list")
+}
+
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("unable to retry %s %s: request body can't be rewound", req.Method, req.URL.Path)
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+
+	return r, nil
+}
+
+// retryDelay returns exponential backoff with equal jitter for the given attempt
+func retryDelay(attempt int) time.Duration {
+	delay := maxRetryDelay
+	if attempt < 16 {
+		delay = minRetryDelay << attempt
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,114 @@
+package conn
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRetryTransport_retryableErrorCode(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"serverInstanceNo":"1"}` {
+			t.Errorf("request body was not rewound on retry: %s", body)
+		}
+
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"responseError": {"returnCode": "25013", "returnMessage": "object in operation"}}`))
+			return
+		}
+		w.Write([]byte(`{"returnCode": "0"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 1, []string{"25013"})}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"serverInstanceNo":"1"}`))
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Status code expected 200 but %d", resp.StatusCode)
+	}
+
+	if calls != 2 {
+		t.Fatalf("Calls expected 2 but %d", calls)
+	}
+}
+
+func TestRetryTransport_notRetryableErrorCode(t *testing.T) {
+	var calls int32
+	errBody := `{"responseError": {"returnCode": "800", "returnMessage": "authority"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(errBody))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, []string{"25013"})}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != errBody {
+		t.Fatalf("Body expected %s but %s", errBody, body)
+	}
+
+	if calls != 1 {
+		t.Fatalf("Calls expected 1 but %d", calls)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	for attempt := 0; attempt < 64; attempt++ {
+		delay := retryDelay(attempt)
+		if delay < minRetryDelay/2 || delay > maxRetryDelay {
+			t.Fatalf("Delay for attempt %d out of range: %s", attempt, delay)
+		}
+	}
+}
+
+func TestRetryTransport_gatewayError(t *testing.T) {
+	cases := []struct {
+		action string
+		calls  int32
+	}{
+		{action: "getServerInstanceList", calls: 2},
+		{action: "createServerInstances", calls: 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.action, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&calls, 1) == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				w.Write([]byte(`{"returnCode": "0"}`))
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 1, nil)}
+			resp, err := client.Post(server.URL+"/vserver/v2/"+c.action, "application/json", strings.NewReader(`{}`))
+			if err != nil {
+				t.Fatalf("Got error: %s", err)
+			}
+			defer resp.Body.Close()
+
+			if calls != c.calls {
+				t.Fatalf("Calls expected %d but %d", c.calls, calls)
+			}
+		})
+	}
+}
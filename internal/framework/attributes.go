@@ -1,9 +1,19 @@
 package framework
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func IDAttribute() schema.StringAttribute {
@@ -14,3 +24,82 @@ func IDAttribute() schema.StringAttribute {
 		},
 	}
 }
+
+// WriteOnlyVersionAttribute returns the version attribute of a write-only attribute.
+// Write-only values never reach the plan, so changing the version is what triggers applying a new value.
+// The resource applies the new value in Update, or rejects the change with DenyWriteOnlyVersionChange
+// when the API cannot change it in place.
+func WriteOnlyVersionAttribute(writeOnlyAttributeName string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(writeOnlyAttributeName)),
+		},
+		Description: fmt.Sprintf("Version of `%s`. Change it to apply a new value of `%s`.", writeOnlyAttributeName, writeOnlyAttributeName),
+	}
+}
+
+// StringValueOrWriteOnly returns the value if it is set, otherwise the value of the write-only attribute
+// read from the configuration, as write-only attributes are always null in the plan.
+func StringValueOrWriteOnly(ctx context.Context, config tfsdk.Config, value types.String, writeOnlyPath path.Path) (types.String, diag.Diagnostics) {
+	if !value.IsNull() {
+		return value, nil
+	}
+
+	var writeOnlyValue types.String
+	diags := config.GetAttribute(ctx, writeOnlyPath, &writeOnlyValue)
+	return writeOnlyValue, diags
+}
+
+// RequiresReplaceUnlessWriteOnly requires replacing the resource when the value changes,
+// except when it is removed in favor of the write-only attribute, which keeps the existing value.
+func RequiresReplaceUnlessWriteOnly(writeOnlyAttributeName string) planmodifier.String {
+	description := fmt.Sprintf("Requires replacement unless the value moves to `%s`.", writeOnlyAttributeName)
+
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			if !req.PlanValue.IsNull() {
+				resp.RequiresReplace = true
+				return
+			}
+
+			var writeOnlyValue types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(writeOnlyAttributeName), &writeOnlyValue)...)
+			resp.RequiresReplace = writeOnlyValue.IsNull()
+		},
+		description,
+		description,
+	)
+}
+
+// DenyWriteOnlyVersionChange rejects changing the version of a write-only attribute of an existing resource
+// whose API cannot change the value in place. Setting the version while moving from the plain attribute to
+// the write-only one is allowed, as the write-only value is expected to match the existing one.
+func DenyWriteOnlyVersionChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, valuePath, versionPath path.Path) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planVersion, stateVersion types.Int64
+	var stateValue types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, versionPath, &planVersion)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, versionPath, &stateVersion)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, valuePath, &stateValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planVersion.IsNull() || planVersion.IsUnknown() || planVersion.Equal(stateVersion) {
+		return
+	}
+
+	if stateVersion.IsNull() && !stateValue.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		versionPath,
+		"Unsupported in-place change",
+		fmt.Sprintf("The API does not support changing `%s` of an existing resource. Restore the previous `%s`, or replace the resource to apply a new value.", valuePath, versionPath),
+	)
+}
@@ -2,6 +2,7 @@ package fwprovider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/hadoop"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/loadbalancer"
@@ -9,20 +10,34 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/postgresql"
 
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/region"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mongodb"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mssql"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/nks"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/redis"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 )
 
+var (
+	_ provider.ProviderWithEphemeralResources = &fwprovider{}
+	_ provider.ProviderWithFunctions          = &fwprovider{}
+)
+
 func New(primary interface{ Meta() interface{} }) provider.Provider {
 	return &fwprovider{
 		Primary: primary,
@@ -60,15 +75,72 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Support VPC platform",
 			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Profile of the shared credentials file",
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the shared credentials file",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Maximum number of retries for transient API errors",
+			},
+			"retryable_error_codes": schema.ListAttribute{
+				Optional:    true,
+				Description: "API error codes to retry with exponential backoff",
+				ElementType: types.StringType,
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Maximum number of API requests per second. 0 disables the limit",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "Maximum number of in-flight API requests. 0 disables the limit",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"endpoints": schema.ListNestedBlock{
+				Description: "Custom endpoints of ncloud API",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: endpointsAttributes(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
 		},
 	}
 }
 
+func endpointsAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(conn.EndpointServices))
+	for _, service := range conn.EndpointServices {
+		attributes[service] = schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Custom endpoint of %s API", service),
+		}
+	}
+	return attributes
+}
+
 func (p *fwprovider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	providerConfig := p.Primary.Meta().(*conn.ProviderConfig)
 
 	resp.DataSourceData = providerConfig
 	resp.ResourceData = providerConfig
+	resp.EphemeralResourceData = providerConfig
 }
 
 func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -79,6 +151,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, vpc.NewVpcsDataSource)
 	dataSources = append(dataSources, vpc.NewSubnetDataSource)
 	dataSources = append(dataSources, vpc.NewSubnetsDataSource)
+	dataSources = append(dataSources, vpc.NewVpcAvailableCidrDataSource)
 	dataSources = append(dataSources, vpc.NewNatGatewayDataSource)
 	dataSources = append(dataSources, vpc.NewVpcPeeringDataSource)
 	dataSources = append(dataSources, server.NewInitScriptDataSource)
@@ -130,6 +203,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, vpc.NewSubnetResource)
 	resources = append(resources, vpc.NewNatGatewayResource)
 	resources = append(resources, vpc.NewVpcPeeringResource)
+	resources = append(resources, vpc.NewVpcPeeringAccepterResource)
 	resources = append(resources, server.NewLoginKeyResource)
 	resources = append(resources, server.NewInitScriptResource)
 	resources = append(resources, mysql.NewMysqlResource)
@@ -151,6 +225,13 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, objectstorage.NewBucketACLResource)
 	resources = append(resources, objectstorage.NewObjectCopyResource)
 
+	// Mask sensitive attributes in request/response logs
+	for _, r := range resources {
+		schemaResp := &resource.SchemaResponse{}
+		r().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		common.AddSensitiveLogFieldsFromFrameworkSchema(schemaResp.Schema.Attributes, schemaResp.Schema.Blocks)
+	}
+
 	if err := errs.ErrorOrNil(); err != nil {
 		tflog.Warn(ctx, "registering resources", map[string]interface{}{
 			"error": err.Error(),
@@ -159,3 +240,22 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 	return resources
 }
+
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	ephemeralResources = append(ephemeralResources, nks.NewNKSKubeConfigEphemeralResource)
+
+	return ephemeralResources
+}
+
+func (p *fwprovider) Functions(ctx context.Context) []func() function.Function {
+	var functions []func() function.Function
+
+	functions = append(functions, server.NewServerSpecFunction)
+	functions = append(functions, nks.NewKubeConfigFunction)
+	functions = append(functions, region.NewRegionNoFunction)
+	functions = append(functions, objectstorage.NewEndpointFunction)
+
+	return functions
+}
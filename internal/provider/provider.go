@@ -7,7 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/region"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/autoscaling"
@@ -103,25 +105,34 @@ func New(ctx context.Context) *schema.Provider {
 
 	resourceMap := map[string]*schema.Resource{
 		"ncloud_access_control_group_rule":           server.ResourceNcloudAccessControlGroupRule(),
+		"ncloud_access_control_group_ingress_rule":   server.ResourceNcloudAccessControlGroupIngressRule(),
+		"ncloud_access_control_group_egress_rule":    server.ResourceNcloudAccessControlGroupEgressRule(),
 		"ncloud_access_control_group":                server.ResourceNcloudAccessControlGroup(),
 		"ncloud_auto_scaling_group":                  autoscaling.ResourceNcloudAutoScalingGroup(),
 		"ncloud_auto_scaling_policy":                 autoscaling.ResourceNcloudAutoScalingPolicy(),
 		"ncloud_auto_scaling_schedule":               autoscaling.ResourceNcloudAutoScalingSchedule(),
+		"ncloud_block_storage_attachment":            server.ResourceNcloudBlockStorageAttachment(),
 		"ncloud_block_storage_snapshot":              server.ResourceNcloudBlockStorageSnapshot(),
 		"ncloud_block_storage":                       server.ResourceNcloudBlockStorage(),
 		"ncloud_cdss_cluster":                        cdss.ResourceNcloudCDSSCluster(),
 		"ncloud_cdss_config_group":                   cdss.ResourceNcloudCDSSConfigGroup(),
+		"ncloud_default_access_control_group":        server.ResourceNcloudDefaultAccessControlGroup(),
+		"ncloud_default_network_acl":                 vpc.ResourceNcloudDefaultNetworkACL(),
 		"ncloud_launch_configuration":                autoscaling.ResourceNcloudLaunchConfiguration(),
 		"ncloud_lb_listener":                         loadbalancer.ResourceNcloudLbListener(),
 		"ncloud_lb_target_group_attachment":          loadbalancer.ResourceNcloudLbTargetGroupAttachment(),
 		"ncloud_lb_target_group":                     loadbalancer.ResourceNcloudLbTargetGroup(),
 		"ncloud_load_balancer_ssl_certificate":       classicloadbalancer.ResourceNcloudLoadBalancerSSLCertificate(),
 		"ncloud_load_balancer":                       classicloadbalancer.ResourceNcloudLoadBalancer(),
+		"ncloud_member_server_image":                 server.ResourceNcloudMemberServerImage(),
 		"ncloud_nas_volume":                          nasvolume.ResourceNcloudNasVolume(),
 		"ncloud_network_acl":                         vpc.ResourceNcloudNetworkACL(),
 		"ncloud_network_acl_deny_allow_group":        vpc.ResourceNcloudNetworkACLDenyAllowGroup(),
 		"ncloud_network_acl_rule":                    vpc.ResourceNcloudNetworkACLRule(),
+		"ncloud_network_acl_ingress_rule":            vpc.ResourceNcloudNetworkACLIngressRule(),
+		"ncloud_network_acl_egress_rule":             vpc.ResourceNcloudNetworkACLEgressRule(),
 		"ncloud_network_interface":                   server.ResourceNcloudNetworkInterface(),
+		"ncloud_network_interface_attachment":        server.ResourceNcloudNetworkInterfaceAttachment(),
 		"ncloud_nks_cluster":                         nks.ResourceNcloudNKSCluster(),
 		"ncloud_nks_node_pool":                       nks.ResourceNcloudNKSNodePool(),
 		"ncloud_placement_group":                     server.ResourceNcloudPlacementGroup(),
@@ -140,6 +151,11 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_sourcepipeline_project":              devtools.ResourceNcloudSourcePipeline(),
 	}
 
+	// Mask sensitive attributes in request/response logs
+	for _, r := range resourceMap {
+		common.AddSensitiveLogFieldsFromSchema(r.Schema)
+	}
+
 	return &schema.Provider{
 		Schema:               SchemaMap(),
 		DataSourcesMap:       dataSourceMap,
@@ -175,11 +191,66 @@ func SchemaMap() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Support VPC platform",
 		},
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Profile of the shared credentials file",
+		},
+		"shared_credentials_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path of the shared credentials file",
+		},
+		"max_retries": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      "Maximum number of retries for transient API errors",
+		},
+		"retryable_error_codes": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "API error codes to retry with exponential backoff",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"max_requests_per_second": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      "Maximum number of API requests per second. 0 disables the limit",
+		},
+		"max_concurrent_requests": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      "Maximum number of in-flight API requests. 0 disables the limit",
+		},
+		"endpoints": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Custom endpoints of ncloud API",
+			Elem: &schema.Resource{
+				Schema: endpointsSchemaMap(),
+			},
+		},
+	}
+}
+
+func endpointsSchemaMap() map[string]*schema.Schema {
+	m := make(map[string]*schema.Schema, len(conn.EndpointServices))
+	for _, service := range conn.EndpointServices {
+		m[service] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Custom endpoint of %s API", service),
+		}
 	}
+	return m
 }
 
 func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	providerConfig := conn.ProviderConfig{
+	providerConfig := &conn.ProviderConfig{
 		SupportVPC: true,
 	}
 
@@ -187,16 +258,15 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		providerConfig.SupportVPC = false
 	}
 
+	// Load shared credentials
+	sharedCredentials, err := loadSharedCredentials(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	// Set site
-	if site, ok := getOrFromEnv(d, "site", "NCLOUD_SITE"); ok {
+	if site, ok := getOrFromEnvOrShared(d, "site", "NCLOUD_SITE", sharedCredentials.Site); ok {
 		providerConfig.Site = site.(string)
-
-		switch site {
-		case "gov":
-			os.Setenv("NCLOUD_API_GW", "https://ncloud.apigw.gov-ntruss.com")
-		case "fin":
-			os.Setenv("NCLOUD_API_GW", "https://fin-ncloud.apigw.fin-ntruss.com")
-		}
 	}
 
 	// Fin only supports VPC
@@ -204,45 +274,67 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		providerConfig.SupportVPC = true
 	}
 
-	accessKey, ok := getOrFromEnv(d, "access_key", "NCLOUD_ACCESS_KEY")
+	accessKey, ok := getOrFromEnvOrShared(d, "access_key", "NCLOUD_ACCESS_KEY", sharedCredentials.AccessKey)
 	if !ok {
 		return nil, diag.Errorf("missing provider configuration: ACCESS_KEY")
 	}
-	secretKey, ok := getOrFromEnv(d, "secret_key", "NCLOUD_SECRET_KEY")
+	secretKey, ok := getOrFromEnvOrShared(d, "secret_key", "NCLOUD_SECRET_KEY", sharedCredentials.SecretKey)
 	if !ok {
 		return nil, diag.Errorf("missing provider configuration: SECRET_KEY")
 	}
-	region, ok := getOrFromEnv(d, "region", "NCLOUD_REGION")
+	region, ok := getOrFromEnvOrShared(d, "region", "NCLOUD_REGION", sharedCredentials.Region)
 	if !ok {
 		return nil, diag.Errorf("missing provider configuration: REGION")
 	}
 
 	// Set client
 	config := conn.Config{
-		AccessKey: accessKey.(string),
-		SecretKey: secretKey.(string),
-		Region:    region.(string),
+		AccessKey:             accessKey.(string),
+		SecretKey:             secretKey.(string),
+		Region:                region.(string),
+		MaxRetries:            conn.DefaultMaxRetries,
+		RetryableErrorCodes:   common.DefaultRetryableErrorCodes,
+		MaxRequestsPerSecond:  conn.DefaultMaxRequestsPerSecond,
+		MaxConcurrentRequests: conn.DefaultMaxConcurrentRequests,
+	}
+
+	// Set rate limit
+	if v, ok := d.GetOkExists("max_requests_per_second"); ok {
+		config.MaxRequestsPerSecond = v.(int)
+	}
+	if v, ok := d.GetOkExists("max_concurrent_requests"); ok {
+		config.MaxConcurrentRequests = v.(int)
 	}
 
-	// Set endpoint (only for debugging)
-	obs_endpoint := os.Getenv("NCLOUD_OBS_ENDPOINT")
+	// Set retry policy
+	if v, ok := d.GetOkExists("max_retries"); ok {
+		config.MaxRetries = v.(int)
+	}
+	if v, ok := d.GetOk("retryable_error_codes"); ok {
+		config.RetryableErrorCodes = common.StringPtrArrToStringArr(common.ExpandStringList(v.([]interface{})))
+	}
+
+	// Set endpoints
+	config.Endpoints = expandEndpoints(d.Get("endpoints").([]interface{}))
+	if v := os.Getenv("NCLOUD_OBS_ENDPOINT"); v != "" && config.Endpoints["object_storage"] == "" {
+		config.Endpoints["object_storage"] = v
+	}
 
-	if client, err := config.Client(providerConfig.Site, obs_endpoint); err != nil {
+	if client, err := config.Client(providerConfig.Site); err != nil {
 		return nil, diag.FromErr(err)
 	} else {
 		providerConfig.Client = client
 	}
 
 	// Set region
-	if err := conn.SetRegionCache(providerConfig.Client, providerConfig.SupportVPC); err != nil {
+	if err := conn.SetRegionCache(providerConfig); err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	if conn.IsValidRegionCode(region.(string)) {
-		os.Setenv("NCLOUD_REGION", region.(string))
+	if conn.IsValidRegionCode(providerConfig, region.(string)) {
 		providerConfig.RegionCode = region.(string)
 		if !providerConfig.SupportVPC {
-			providerConfig.RegionNo = *conn.GetRegionNoByCode(region.(string))
+			providerConfig.RegionNo = *conn.GetRegionNoByCode(providerConfig, region.(string))
 		}
 	} else {
 		return nil, []diag.Diagnostic{
@@ -253,7 +345,67 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		}
 	}
 
-	return &providerConfig, nil
+	return providerConfig, nil
+}
+
+func expandEndpoints(l []interface{}) map[string]string {
+	endpoints := make(map[string]string)
+	if len(l) == 0 || l[0] == nil {
+		return endpoints
+	}
+
+	for service, v := range l[0].(map[string]interface{}) {
+		if endpoint := v.(string); endpoint != "" {
+			endpoints[service] = endpoint
+		}
+	}
+	return endpoints
+}
+
+// loadSharedCredentials reads the profile of the shared credentials file.
+// The default file is only read when credentials or region are not set by the provider block or environment variables.
+func loadSharedCredentials(d *schema.ResourceData) (*conn.SharedCredentials, error) {
+	profile, profileOk := getOrFromEnv(d, "profile", "NCLOUD_PROFILE")
+	filename, filenameOk := getOrFromEnv(d, "shared_credentials_file", "NCLOUD_SHARED_CREDENTIALS_FILE")
+
+	if !profileOk && !filenameOk {
+		_, accessKeyOk := getOrFromEnv(d, "access_key", "NCLOUD_ACCESS_KEY")
+		_, secretKeyOk := getOrFromEnv(d, "secret_key", "NCLOUD_SECRET_KEY")
+		_, regionOk := getOrFromEnv(d, "region", "NCLOUD_REGION")
+		if accessKeyOk && secretKeyOk && regionOk {
+			return &conn.SharedCredentials{}, nil
+		}
+	}
+
+	if !profileOk {
+		profile = conn.DefaultProfile
+	}
+
+	if !filenameOk {
+		defaultFile, err := conn.DefaultSharedCredentialsFile()
+		if err != nil {
+			return nil, err
+		}
+
+		// The default file is optional unless a profile is set
+		if _, err := os.Stat(defaultFile); err != nil && !profileOk {
+			return &conn.SharedCredentials{}, nil
+		}
+		filename = defaultFile
+	}
+
+	return conn.LoadSharedCredentials(filename.(string), profile.(string))
+}
+
+func getOrFromEnvOrShared(d *schema.ResourceData, name, env, shared string) (any, bool) {
+	if v, ok := getOrFromEnv(d, name, env); ok {
+		return v, true
+	}
+
+	if shared != "" {
+		return shared, true
+	}
+	return nil, false
 }
 
 func getOrFromEnv(d *schema.ResourceData, name, env string) (any, bool) {
@@ -0,0 +1,53 @@
+package region
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var _ function.Function = &regionNoFunction{}
+
+func NewRegionNoFunction() function.Function {
+	return &regionNoFunction{}
+}
+
+type regionNoFunction struct{}
+
+func (f *regionNoFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_no"
+}
+
+func (f *regionNoFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the region number of a region code",
+		Description: "Returns the region number of a region code (e.g. `KR`) in the classic environment.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "code",
+				Description: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *regionNoFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var code string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &code))
+	if resp.Error != nil {
+		return
+	}
+
+	regionNo, ok := conn.ClassicRegionNo(code)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("no region data for region_code `%s`", code))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, regionNo))
+}
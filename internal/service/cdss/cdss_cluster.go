@@ -241,13 +241,13 @@ func resourceNcloudCDSSClusterCreate(ctx context.Context, d *schema.ResourceData
 		ConfigGroupNo:            *GetInt32FromString(d.GetOk("config_group_no")),
 	}
 
-	LogCommonRequest("resourceNcloudCDSSClusterCreate", reqParams)
+	LogCommonRequestContext(ctx, "resourceNcloudCDSSClusterCreate", reqParams)
 	resp, _, err := config.Client.Vcdss.V1Api.ClusterCreateCDSSClusterReturnServiceGroupInstanceNoPost(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("resourceNcloudCDSSClusterCreate", err, reqParams)
+		LogErrorResponseContext(ctx, "resourceNcloudCDSSClusterCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("resourceNcloudCDSSClusterCreate", resp)
+	LogResponseContext(ctx, "resourceNcloudCDSSClusterCreate", resp)
 
 	id := strconv.Itoa(int(ncloud.Int32Value(&resp.Result.ServiceGroupInstanceNo)))
 	if err := waitForCDSSClusterActive(ctx, d, config, id); err != nil {
@@ -371,7 +371,7 @@ func checkConfigGroupNoChanged(ctx context.Context, d *schema.ResourceData, conf
 		_, n := d.GetChange("config_group_no")
 
 		newConfigGroupNo := n.(string)
-		LogCommonRequest("resourceNcloudCDSSClusterUpdate", d.Id())
+		LogCommonRequestContext(ctx, "resourceNcloudCDSSClusterUpdate", d.Id())
 		if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
 			return diag.FromErr(err)
 		}
@@ -382,7 +382,7 @@ func checkConfigGroupNoChanged(ctx context.Context, d *schema.ResourceData, conf
 		}
 
 		if _, _, err := config.Client.Vcdss.V1Api.ConfigGroupSetClusterKafkaConfigGroupConfigGroupNoPost(ctx, reqParams, newConfigGroupNo); err != nil {
-			LogErrorResponse("resourceNcloudCDSSClusterUpdate", err, d.Id())
+			LogErrorResponseContext(ctx, "resourceNcloudCDSSClusterUpdate", err, d.Id())
 			return diag.FromErr(err)
 		}
 		if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
@@ -399,7 +399,7 @@ func checkCmakPasswordChanged(ctx context.Context, d *schema.ResourceData, confi
 		oldCmakMap := o.([]interface{})[0].(map[string]interface{})
 		newCmakMap := n.([]interface{})[0].(map[string]interface{})
 		if oldCmakMap["user_password"] != newCmakMap["user_password"] {
-			LogCommonRequest("resourceNcloudCDSSClusterUpdate", d.Id())
+			LogCommonRequestContext(ctx, "resourceNcloudCDSSClusterUpdate", d.Id())
 			if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
 				return diag.FromErr(err)
 			}
@@ -409,7 +409,7 @@ func checkCmakPasswordChanged(ctx context.Context, d *schema.ResourceData, confi
 			}
 
 			if _, _, err := config.Client.Vcdss.V1Api.ClusterResetCMAKPasswordServiceGroupInstanceNoPost(ctx, reqParams, d.Id()); err != nil {
-				LogErrorResponse("resourceNcloudCDSSClusterResetCmakUserPassword", err, d.Id())
+				LogErrorResponseContext(ctx, "resourceNcloudCDSSClusterResetCmakUserPassword", err, d.Id())
 				return diag.FromErr(err)
 			}
 
@@ -432,7 +432,7 @@ func checkNodeCountChanged(ctx context.Context, d *schema.ResourceData, config *
 		newDataNodeCount := *Int32PtrOrNil(newBrokerNodesMap["node_count"], true)
 
 		if oldDataNodeCount < newDataNodeCount {
-			LogCommonRequest("resourceNcloudCDSSClusterUpdate", d.Id())
+			LogCommonRequestContext(ctx, "resourceNcloudCDSSClusterUpdate", d.Id())
 			if err := waitForCDSSClusterActive(ctx, d, config, d.Id()); err != nil {
 				return fmt.Errorf("error waiting for CDSS Cluster (%s) to become activating: %s", d.Id(), err)
 			}
@@ -442,7 +442,7 @@ func checkNodeCountChanged(ctx context.Context, d *schema.ResourceData, config *
 			}
 
 			if _, _, err := config.Client.Vcdss.V1Api.ClusterChangeCountOfBrokerNodeServiceGroupInstanceNoPost(ctx, reqParams, d.Id()); err != nil {
-				LogErrorResponse("resourceNcloudCDSSClusterAddNodes", err, d.Id())
+				LogErrorResponseContext(ctx, "resourceNcloudCDSSClusterAddNodes", err, d.Id())
 				return fmt.Errorf("error Add Nodes to CDSS Cluster (%s) : %s", d.Id(), err)
 			}
 
@@ -450,7 +450,7 @@ func checkNodeCountChanged(ctx context.Context, d *schema.ResourceData, config *
 				return fmt.Errorf("error waiting for CDSS Cluster (%s) to become activating: %s", d.Id(), err)
 			}
 		} else if oldDataNodeCount > newDataNodeCount {
-			LogErrorResponse("resourceNcloudCDSSClusterAddNodes", nil, d.Id())
+			LogErrorResponseContext(ctx, "resourceNcloudCDSSClusterAddNodes", nil, d.Id())
 			return fmt.Errorf("broker node count cannot be decreased")
 		}
 	}
@@ -471,7 +471,7 @@ func checkCDSSNodeProductCodeChanged(ctx context.Context, d *schema.ResourceData
 		}
 
 		if _, _, err := config.Client.Vcdss.V1Api.ClusterChangeSpecNodeServiceGroupInstanceNoPost(ctx, reqParams, d.Id()); err != nil {
-			LogErrorResponse("resourceNcloudCDSSClusterChangeSpec", nil, d.Id())
+			LogErrorResponseContext(ctx, "resourceNcloudCDSSClusterChangeSpec", nil, d.Id())
 			return fmt.Errorf("error Change Node Product Code (%s) : %s", d.Id(), err)
 		}
 
@@ -508,9 +508,9 @@ func resourceNcloudCDSSClusterDelete(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("resourceNcloudCDSSClusterDelete", d.Id())
+	LogCommonRequestContext(ctx, "resourceNcloudCDSSClusterDelete", d.Id())
 	if _, _, err := config.Client.Vcdss.V1Api.ClusterDeleteCDSSClusterServiceGroupInstanceNoDelete(ctx, d.Id()); err != nil {
-		LogErrorResponse("resourceNcloudCDSSClusterDelete", err, d.Id())
+		LogErrorResponseContext(ctx, "resourceNcloudCDSSClusterDelete", err, d.Id())
 		return diag.FromErr(err)
 	}
 
@@ -574,7 +574,7 @@ func getCDSSCluster(ctx context.Context, config *conn.ProviderConfig, id string)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getCDSSCluster", resp)
+	LogResponseContext(ctx, "getCDSSCluster", resp)
 
 	return resp.Result, nil
 }
@@ -584,7 +584,7 @@ func getBrokerInfo(ctx context.Context, config *conn.ProviderConfig, id string)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getBrokerInfo", resp)
+	LogResponseContext(ctx, "getBrokerInfo", resp)
 
 	return resp.Result, nil
 }
@@ -76,13 +76,13 @@ func resourceNcloudCDSSConfigGroupCreate(ctx context.Context, d *schema.Resource
 		reqParams.Description = *description
 	}
 
-	LogCommonRequest("resourceNcloudCDSSConfigGroupCreate", reqParams)
+	LogCommonRequestContext(ctx, "resourceNcloudCDSSConfigGroupCreate", reqParams)
 	resp, _, err := config.Client.Vcdss.V1Api.ConfigGroupCreateConfigGroupPost(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("resourceNcloudCDSSConfigGroupCreate", err, reqParams)
+		LogErrorResponseContext(ctx, "resourceNcloudCDSSConfigGroupCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("resourceNcloudCDSSConfigGroupCreate", resp)
+	LogResponseContext(ctx, "resourceNcloudCDSSConfigGroupCreate", resp)
 
 	id := strconv.Itoa(int(ncloud.Int32Value(&resp.Result.ConfigGroupNo)))
 	d.SetId(id)
@@ -122,7 +122,7 @@ func resourceNcloudCDSSConfigGroupUpdate(ctx context.Context, d *schema.Resource
 		_, n := d.GetChange("description")
 
 		newDescription := n.(string)
-		LogCommonRequest("resourceNcloudCDSSConfigGroupUpdate", d.Id())
+		LogCommonRequestContext(ctx, "resourceNcloudCDSSConfigGroupUpdate", d.Id())
 
 		reqParams := vcdss.SetKafkaConfigGroupMemoRequest{
 			KafkaVersionCode: *StringPtrOrNil(d.GetOk("kafka_version_code")),
@@ -130,7 +130,7 @@ func resourceNcloudCDSSConfigGroupUpdate(ctx context.Context, d *schema.Resource
 		}
 
 		if _, _, err := config.Client.Vcdss.V1Api.ConfigGroupSetKafkaConfigGroupMemoConfigGroupNoPost(ctx, reqParams, d.Id()); err != nil {
-			LogErrorResponse("resourceNcloudCDSSConfigGroupUpdate", err, d.Id())
+			LogErrorResponseContext(ctx, "resourceNcloudCDSSConfigGroupUpdate", err, d.Id())
 			return diag.FromErr(err)
 		}
 	}
@@ -143,9 +143,9 @@ func resourceNcloudCDSSConfigGroupDelete(ctx context.Context, d *schema.Resource
 		return diag.FromErr(NotSupportClassic("resource `ncloud_cdss_config_group`"))
 	}
 
-	LogCommonRequest("resourceNcloudCDSSConfigGroupDelete", d.Id())
+	LogCommonRequestContext(ctx, "resourceNcloudCDSSConfigGroupDelete", d.Id())
 	if _, _, err := config.Client.Vcdss.V1Api.ConfigGroupDeleteConfigGroupConfigGroupNoDelete(ctx, d.Id()); err != nil {
-		LogErrorResponse("resourceNcloudCDSSConfigGroupDelete", err, d.Id())
+		LogErrorResponseContext(ctx, "resourceNcloudCDSSConfigGroupDelete", err, d.Id())
 		return diag.FromErr(err)
 	}
 
@@ -156,13 +156,13 @@ func getCDSSConfigGroup(ctx context.Context, config *conn.ProviderConfig, kafkaV
 	reqParams := vcdss.GetKafkaConfigGroupRequest{
 		KafkaVersionCode: kafkaVersionCode,
 	}
-	LogCommonRequest("getCDSSConfigGroup", reqParams)
+	LogCommonRequestContext(ctx, "getCDSSConfigGroup", reqParams)
 
 	resp, _, err := config.Client.Vcdss.V1Api.ConfigGroupGetKafkaConfigGroupConfigGroupNoPost(ctx, reqParams, id)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getCDSSConfigGroup", resp)
+	LogResponseContext(ctx, "getCDSSConfigGroup", resp)
 
 	return resp.Result, nil
 }
@@ -534,10 +534,10 @@ func resourceNcloudSourceBuildProjectDelete(ctx context.Context, d *schema.Resou
 
 	id := ncloud.String(d.Id())
 
-	LogCommonRequest("deleteSourceBuildProject", id)
+	LogCommonRequestContext(ctx, "deleteSourceBuildProject", id)
 	err := config.Client.Sourcebuild.V1Api.DeleteProject(ctx, id)
 	if err != nil {
-		LogErrorResponse("deleteSourceBuildProject", err, id)
+		LogErrorResponseContext(ctx, "deleteSourceBuildProject", err, id)
 		return diag.FromErr(err)
 	}
 
@@ -565,13 +565,13 @@ func changeBuildProject(ctx context.Context, d *schema.ResourceData, config *con
 	id := ncloud.String(d.Id())
 
 	var resp *sourcebuild.CreateProjectResponse
-	LogCommonRequest("updateSourceBuildProject", reqParams)
+	LogCommonRequestContext(ctx, "updateSourceBuildProject", reqParams)
 	resp, err := config.Client.Sourcebuild.V1Api.ChangeProject(ctx, reqParams, id)
 	if err != nil {
-		LogErrorResponse("updateSourceBuildProject", err, id)
+		LogErrorResponseContext(ctx, "updateSourceBuildProject", err, id)
 		return err
 	}
-	LogResponse("updateSourceBuildProject", resp)
+	LogResponseContext(ctx, "updateSourceBuildProject", resp)
 
 	return nil
 }
@@ -797,15 +797,15 @@ func resourceNcloudSourceBuildProjectRead(ctx context.Context, d *schema.Resourc
 }
 
 func getBuildProject(ctx context.Context, config *conn.ProviderConfig, id *string) (*sourcebuild.GetProjectDetailResponse, error) {
-	LogCommonRequest("getSourceBuildProjectDetail", id)
+	LogCommonRequestContext(ctx, "getSourceBuildProjectDetail", id)
 	resp, err := config.Client.Sourcebuild.V1Api.GetProject(ctx, id)
 
 	if err != nil {
-		LogErrorResponse("getSourceBuildProjectDetail", err, id)
+		LogErrorResponseContext(ctx, "getSourceBuildProjectDetail", err, id)
 		return nil, err
 	}
 
-	LogResponse("getSourceBuildProjectDetail", resp)
+	LogResponseContext(ctx, "getSourceBuildProjectDetail", resp)
 
 	return convertBuildProject(resp), nil
 }
@@ -42,13 +42,13 @@ func DataSourceNcloudSourceBuildComputes() *schema.Resource {
 func dataSourceNcloudSourceBuildComputesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	LogCommonRequest("GetComputeEnv", "")
+	LogCommonRequestContext(ctx, "GetComputeEnv", "")
 	resp, err := config.Client.Sourcebuild.V1Api.GetComputeEnv(ctx)
 	if err != nil {
-		LogErrorResponse("GetComputeEnv", err, "")
+		LogErrorResponseContext(ctx, "GetComputeEnv", err, "")
 		return diag.FromErr(err)
 	}
-	LogResponse("GetComputeEnv", resp)
+	LogResponseContext(ctx, "GetComputeEnv", resp)
 
 	resources := []map[string]interface{}{}
 
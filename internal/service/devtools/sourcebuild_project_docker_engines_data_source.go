@@ -38,13 +38,13 @@ func DataSourceNcloudSourceBuildDockerEngines() *schema.Resource {
 func dataSourceNcloudSourceBuildDockerEnginesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	LogCommonRequest("GetDockerEnv", "")
+	LogCommonRequestContext(ctx, "GetDockerEnv", "")
 	resp, err := config.Client.Sourcebuild.V1Api.GetDockerEnv(context.Background())
 	if err != nil {
-		LogErrorResponse("GetDockerEnv", err, "")
+		LogErrorResponseContext(ctx, "GetDockerEnv", err, "")
 		return diag.FromErr(err)
 	}
-	LogResponse("GetDockerEnv", resp)
+	LogResponseContext(ctx, "GetDockerEnv", resp)
 
 	resources := []map[string]interface{}{}
 
@@ -46,13 +46,13 @@ func DataSourceNcloudSourceBuildOs() *schema.Resource {
 func dataSourceNcloudSourceBuildOsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*conn.ProviderConfig)
 
-	LogCommonRequest("GetOsEnv", "")
+	LogCommonRequestContext(ctx, "GetOsEnv", "")
 	resp, err := config.Client.Sourcebuild.V1Api.GetOsEnv(ctx)
 	if err != nil {
-		LogErrorResponse("GetOsEnv", err, "")
+		LogErrorResponseContext(ctx, "GetOsEnv", err, "")
 		return diag.FromErr(err)
 	}
-	LogResponse("GetOsEnv", resp)
+	LogResponseContext(ctx, "GetOsEnv", resp)
 
 	resources := []map[string]interface{}{}
 
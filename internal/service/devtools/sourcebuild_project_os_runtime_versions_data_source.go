@@ -52,13 +52,13 @@ func dataSourceNcloudSourceBuildRuntimeVersionsRead(ctx context.Context, d *sche
 	runtimeIdParam := Int32PtrOrNil(d.GetOk("runtime_id"))
 	runtimeId := ncloud.IntString(int(ncloud.Int32Value(runtimeIdParam)))
 
-	LogCommonRequest("GetRuntimeVersionEnv", "")
+	LogCommonRequestContext(ctx, "GetRuntimeVersionEnv", "")
 	resp, err := config.Client.Sourcebuild.V1Api.GetRuntimeVersionEnv(ctx, osId, runtimeId)
 	if err != nil {
-		LogErrorResponse("GetRuntimeVersionEnv", err, "")
+		LogErrorResponseContext(ctx, "GetRuntimeVersionEnv", err, "")
 		return diag.FromErr(err)
 	}
-	LogResponse("GetRuntimeVersionEnv", resp)
+	LogResponseContext(ctx, "GetRuntimeVersionEnv", resp)
 
 	resources := []map[string]interface{}{}
 
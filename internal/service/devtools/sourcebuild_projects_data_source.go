@@ -58,13 +58,13 @@ func dataSourceNcloudSourceBuildProjectsRead(ctx context.Context, d *schema.Reso
 	reqParams := make(map[string]interface{})
 	reqParams["projectName"] = ncloud.StringValue(StringPtrOrNil(d.GetOk("project_name")))
 
-	LogCommonRequest("GetSourceBuildProjects", reqParams)
+	LogCommonRequestContext(ctx, "GetSourceBuildProjects", reqParams)
 	resp, err := config.Client.Sourcebuild.V1Api.GetProjects(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("GetSourceBuildProjects", err, reqParams)
+		LogErrorResponseContext(ctx, "GetSourceBuildProjects", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("GetSourceBuildProjects", resp)
+	LogResponseContext(ctx, "GetSourceBuildProjects", resp)
 
 	resources := []map[string]interface{}{}
 
@@ -87,12 +87,12 @@ func resourceNcloudSourceCommitRepositoryCreate(ctx context.Context, d *schema.R
 		}
 	}
 
-	LogCommonRequest("resourceNcloudSourceCommitRepositoryCreate", reqParams)
+	LogCommonRequestContext(ctx, "resourceNcloudSourceCommitRepositoryCreate", reqParams)
 	resp, err := config.Client.Sourcecommit.V1Api.CreateRepository(ctx, reqParams)
 	var diags diag.Diagnostics
 
 	if err != nil {
-		LogErrorResponse("resourceNcloudSourceCommitRepositoryCreate", err, reqParams)
+		LogErrorResponseContext(ctx, "resourceNcloudSourceCommitRepositoryCreate", err, reqParams)
 
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -102,7 +102,7 @@ func resourceNcloudSourceCommitRepositoryCreate(ctx context.Context, d *schema.R
 		return diags
 	}
 
-	LogResponse("resourceNcloudSourceCommitRepositoryCreate", resp)
+	LogResponseContext(ctx, "resourceNcloudSourceCommitRepositoryCreate", resp)
 
 	name := ncloud.StringValue(reqParams.Name)
 
@@ -123,11 +123,11 @@ func resourceNcloudSourceCommitRepositoryRead(ctx context.Context, d *schema.Res
 	config := meta.(*conn.ProviderConfig)
 	name := ncloud.String(d.Get("name").(string))
 
-	LogCommonRequest("resourceNcloudSourceCommitRepositoryRead", name)
+	LogCommonRequestContext(ctx, "resourceNcloudSourceCommitRepositoryRead", name)
 	var diags diag.Diagnostics
 	repository, err := getRepository(ctx, config, *name)
 	if err != nil {
-		LogErrorResponse("resourceNcloudSourceCommitRepositoryRead", err, *name)
+		LogErrorResponseContext(ctx, "resourceNcloudSourceCommitRepositoryRead", err, *name)
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to search repository",
@@ -136,7 +136,7 @@ func resourceNcloudSourceCommitRepositoryRead(ctx context.Context, d *schema.Res
 		return diags
 	}
 
-	LogResponse("resourceNcloudSourceCommitRepositoryRead", repository)
+	LogResponseContext(ctx, "resourceNcloudSourceCommitRepositoryRead", repository)
 
 	if repository == nil {
 		d.SetId("")
@@ -170,15 +170,15 @@ func resourceNcloudSourceCommitRepositoryUpdate(ctx context.Context, d *schema.R
 
 		id := ncloud.String(d.Id())
 
-		LogCommonRequest("resourceNcloudSourceCommitRepositoryUpdate", reqParams)
+		LogCommonRequestContext(ctx, "resourceNcloudSourceCommitRepositoryUpdate", reqParams)
 		_, err := config.Client.Sourcecommit.V1Api.ChangeRepository(ctx, reqParams, id)
 
 		if err != nil {
-			LogErrorResponse("resourceNcloudSourceCommitRepositoryUpdate", err, *id)
+			LogErrorResponseContext(ctx, "resourceNcloudSourceCommitRepositoryUpdate", err, *id)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudSourceCommitRepositoryUpdate", id)
+		LogResponseContext(ctx, "resourceNcloudSourceCommitRepositoryUpdate", id)
 	}
 
 	return resourceNcloudSourceCommitRepositoryRead(ctx, d, meta)
@@ -189,14 +189,14 @@ func resourceNcloudSourceCommitRepositoryDelete(ctx context.Context, d *schema.R
 
 	id := ncloud.String(d.Id())
 
-	LogCommonRequest("resourceNcloudSourceCommitRepositoryDelete", *id)
+	LogCommonRequestContext(ctx, "resourceNcloudSourceCommitRepositoryDelete", *id)
 
 	if _, err := config.Client.Sourcecommit.V1Api.DeleteRepository(ctx, id); err != nil {
-		LogErrorResponse("resourceNcloudSourceCommitRepositoryDelete", err, *id)
+		LogErrorResponseContext(ctx, "resourceNcloudSourceCommitRepositoryDelete", err, *id)
 		return diag.FromErr(err)
 	}
 
-	LogResponse("resourceNcloudSourceCommitRepositoryDelete", id)
+	LogResponseContext(ctx, "resourceNcloudSourceCommitRepositoryDelete", id)
 	d.SetId("")
 	return nil
 }
@@ -234,40 +234,40 @@ func waitForSourceCommitRepositoryActive(ctx context.Context, d *schema.Resource
 
 func getRepository(ctx context.Context, config *conn.ProviderConfig, name string) (*sourcecommit.GetRepositoryDetailResponse, error) {
 
-	LogCommonRequest("getRepository", name)
+	LogCommonRequestContext(ctx, "getRepository", name)
 	resp, err := config.Client.Sourcecommit.V1Api.GetRepository(ctx, &name)
 
 	if err != nil {
-		LogErrorResponse("getRepository", err, name)
+		LogErrorResponseContext(ctx, "getRepository", err, name)
 		return nil, err
 	}
-	LogResponse("getRepository", resp)
+	LogResponseContext(ctx, "getRepository", resp)
 
 	return resp, nil
 }
 
 func GetRepositoryById(ctx context.Context, config *conn.ProviderConfig, id string) (*sourcecommit.GetRepositoryDetailResponse, error) {
 
-	LogCommonRequest("getRepositoryById", id)
+	LogCommonRequestContext(ctx, "getRepositoryById", id)
 	resp, err := config.Client.Sourcecommit.V1Api.GetRepositoryById(ctx, &id)
 
 	if err != nil {
-		LogErrorResponse("getRepositoryById", err, id)
+		LogErrorResponseContext(ctx, "getRepositoryById", err, id)
 		return nil, err
 	}
-	LogResponse("getRepositoryById", resp)
+	LogResponseContext(ctx, "getRepositoryById", resp)
 
 	return resp, nil
 }
 
 func GetRepositories(ctx context.Context, config *conn.ProviderConfig) (*sourcecommit.GetRepositoryListResponse, error) {
-	LogCommonRequest("getRepositories", "")
+	LogCommonRequestContext(ctx, "getRepositories", "")
 	resp, err := config.Client.Sourcecommit.V1Api.GetRepositories(ctx)
 	if err != nil {
-		LogErrorResponse("getRepositories", err, "")
+		LogErrorResponseContext(ctx, "getRepositories", err, "")
 		return nil, err
 	}
-	LogResponse("getRepositories", resp)
+	LogResponseContext(ctx, "getRepositories", resp)
 
 	return resp, nil
 }
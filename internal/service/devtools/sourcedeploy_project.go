@@ -56,13 +56,13 @@ func resourceNcloudSourceDeployProjectCreate(ctx context.Context, d *schema.Reso
 		Name: StringPtrOrNil(d.GetOk("name")),
 	}
 
-	LogCommonRequest("CreateSourceDeployProject", reqParams)
+	LogCommonRequestContext(ctx, "CreateSourceDeployProject", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.CreateProject(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("CreateSourceDeployProject", err, reqParams)
+		LogErrorResponseContext(ctx, "CreateSourceDeployProject", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("CreateSourceDeployProject", resp)
+	LogResponseContext(ctx, "CreateSourceDeployProject", resp)
 	d.SetId(*ncloud.IntString(int(ncloud.Int32Value(resp.Id))))
 
 	return resourceNcloudSourceDeployProjectRead(ctx, d, meta)
@@ -96,14 +96,14 @@ func resourceNcloudSourceDeployProjectDelete(ctx context.Context, d *schema.Reso
 		return diag.FromErr(NotSupportClassic("resource `ncloud_sourcedeploy_project`"))
 	}
 
-	LogCommonRequest("DeleteSourceDeployProject", d.Id())
+	LogCommonRequestContext(ctx, "DeleteSourceDeployProject", d.Id())
 	resp, err := config.Client.Vsourcedeploy.V1Api.DeleteProject(ctx, ncloud.String(d.Id()))
 	if err != nil {
-		LogErrorResponse("DeleteSourceDeployProject", err, d.Id())
+		LogErrorResponseContext(ctx, "DeleteSourceDeployProject", err, d.Id())
 		return diag.FromErr(err)
 	}
 
-	LogResponse("DeleteSourceDeployProject", resp)
+	LogResponseContext(ctx, "DeleteSourceDeployProject", resp)
 	d.SetId("")
 	return nil
 }
@@ -137,13 +137,13 @@ func GetSourceDeployProjectByName(ctx context.Context, config *conn.ProviderConf
 func getSourceDeployProjects(ctx context.Context, config *conn.ProviderConfig) ([]*vsourcedeploy.GetIdNameResponse, error) {
 	reqParams := make(map[string]interface{})
 
-	LogCommonRequest("GetSourceDeployProjects", reqParams)
+	LogCommonRequestContext(ctx, "GetSourceDeployProjects", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.GetProjects(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("GetSourceDeployProjects", err, reqParams)
+		LogErrorResponseContext(ctx, "GetSourceDeployProjects", err, reqParams)
 		return nil, err
 	}
-	LogResponse("GetSourceDeployProjects", resp)
+	LogResponseContext(ctx, "GetSourceDeployProjects", resp)
 
 	return resp.ProjectList, nil
 }
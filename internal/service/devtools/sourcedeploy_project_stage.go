@@ -126,13 +126,13 @@ func resourceNcloudSourceDeployStageCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(paramsErr)
 	}
 	projectId := ncloud.IntString(d.Get("project_id").(int))
-	LogCommonRequest("createSourceDeployStage", reqParams)
+	LogCommonRequestContext(ctx, "createSourceDeployStage", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.CreateStage(ctx, reqParams, projectId)
 	if err != nil {
-		LogErrorResponse("createSourceDeployStage", err, reqParams)
+		LogErrorResponseContext(ctx, "createSourceDeployStage", err, reqParams)
 		return diag.FromErr(err)
 	}
-	LogResponse("createSourceDeployStage", resp.Id)
+	LogResponseContext(ctx, "createSourceDeployStage", resp.Id)
 
 	d.SetId(*ncloud.IntString(int(ncloud.Int32Value(resp.Id))))
 	d.Set("project_id", Int32PtrOrNil(d.GetOk("project_id")))
@@ -184,14 +184,14 @@ func resourceNcloudSourceDeployStageDelete(ctx context.Context, d *schema.Resour
 	}
 
 	projectId := ncloud.IntString(d.Get("project_id").(int))
-	LogCommonRequest("deleteSourceDeployStage", d.Id())
+	LogCommonRequestContext(ctx, "deleteSourceDeployStage", d.Id())
 	resp, err := config.Client.Vsourcedeploy.V1Api.DeleteStage(ctx, projectId, ncloud.String(d.Id()))
 	if err != nil {
-		LogErrorResponse("deleteSourceDeployStage", err, d.Id())
+		LogErrorResponseContext(ctx, "deleteSourceDeployStage", err, d.Id())
 		return diag.FromErr(err)
 	}
 
-	LogResponse("deleteSourceDeployStage", resp)
+	LogResponseContext(ctx, "deleteSourceDeployStage", resp)
 	d.SetId("")
 	return nil
 }
@@ -246,13 +246,13 @@ func getDeployTarget(d *schema.ResourceData) (*vsourcedeploy.StageConfig, error)
 }
 
 func GetSourceDeployStageById(ctx context.Context, config *conn.ProviderConfig, projectId *string, id *string) (*vsourcedeploy.GetStageDetailResponse, error) {
-	LogCommonRequest("getSourceDeployStage", id)
+	LogCommonRequestContext(ctx, "getSourceDeployStage", id)
 	resp, err := config.Client.Vsourcedeploy.V1Api.GetStage(ctx, projectId, id)
 	if err != nil {
-		LogErrorResponse("getSourceDeployStage", err, *id)
+		LogErrorResponseContext(ctx, "getSourceDeployStage", err, *id)
 		return nil, err
 	}
-	LogResponse("getSourceDeployStage", resp)
+	LogResponseContext(ctx, "getSourceDeployStage", resp)
 
 	return resp, nil
 }
@@ -283,13 +283,13 @@ func changeDeployStage(ctx context.Context, d *schema.ResourceData, config *conn
 	projectId := ncloud.IntString(d.Get("project_id").(int))
 	id := ncloud.String(d.Id())
 
-	LogCommonRequest("changeSourceDeployStage", reqParams)
+	LogCommonRequestContext(ctx, "changeSourceDeployStage", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.ChangeStage(ctx, reqParams, projectId, id)
 	if err != nil {
-		LogErrorResponse("changeSourceDeployStage", err, reqParams)
+		LogErrorResponseContext(ctx, "changeSourceDeployStage", err, reqParams)
 		return err
 	}
-	LogResponse("changeSourceDeployStage", resp)
+	LogResponseContext(ctx, "changeSourceDeployStage", resp)
 
 	return nil
 }
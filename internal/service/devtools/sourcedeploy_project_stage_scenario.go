@@ -430,13 +430,13 @@ func resourceNcloudSourceDeployScenarioCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(paramsErr)
 	}
 
-	LogCommonRequest("createSourceDeployScenario", reqParams)
+	LogCommonRequestContext(ctx, "createSourceDeployScenario", reqParams)
 	scenarioCreateResp, scenarioCreateRespErr := config.Client.Vsourcedeploy.V1Api.CreateScenario(ctx, reqParams, projectId, stageId)
 	if scenarioCreateRespErr != nil {
-		LogErrorResponse("createSourceDeployScenario", scenarioCreateRespErr, reqParams)
+		LogErrorResponseContext(ctx, "createSourceDeployScenario", scenarioCreateRespErr, reqParams)
 		return diag.FromErr(scenarioCreateRespErr)
 	}
-	LogResponse("createSourceDeployScenario", scenarioCreateResp.Id)
+	LogResponseContext(ctx, "createSourceDeployScenario", scenarioCreateResp.Id)
 
 	d.SetId(*ncloud.IntString(int(ncloud.Int32Value(scenarioCreateResp.Id))))
 
@@ -827,13 +827,13 @@ func getCanaryConfigAnalysisConfig(d *schema.ResourceData) (*vsourcedeploy.Scena
 }
 
 func GetSourceDeployScenarioById(ctx context.Context, config *conn.ProviderConfig, projectId *string, stageId *string, id *string) (*vsourcedeploy.GetScenarioDetailResponse, error) {
-	LogCommonRequest("getSourceDeployScenario", id)
+	LogCommonRequestContext(ctx, "getSourceDeployScenario", id)
 	resp, err := config.Client.Vsourcedeploy.V1Api.GetScenario(ctx, projectId, stageId, id)
 	if err != nil {
-		LogErrorResponse("getSourceDeployScenario", err, *id)
+		LogErrorResponseContext(ctx, "getSourceDeployScenario", err, *id)
 		return nil, err
 	}
-	LogResponse("getSourceDeployScenario", resp)
+	LogResponseContext(ctx, "getSourceDeployScenario", resp)
 
 	return resp, nil
 }
@@ -846,13 +846,13 @@ func resourceNcloudSourceDeployScenarioDelete(ctx context.Context, d *schema.Res
 
 	projectId := ncloud.IntString(d.Get("project_id").(int))
 	stageId := ncloud.IntString(d.Get("stage_id").(int))
-	LogCommonRequest("deleteSourceDeployScenario", d.Id())
+	LogCommonRequestContext(ctx, "deleteSourceDeployScenario", d.Id())
 	resp, err := config.Client.Vsourcedeploy.V1Api.DeleteScenario(ctx, projectId, stageId, ncloud.String(d.Id()))
 	if err != nil {
-		LogErrorResponse("deleteSourceDeployScenario", err, d.Id())
+		LogErrorResponseContext(ctx, "deleteSourceDeployScenario", err, d.Id())
 		return diag.FromErr(err)
 	}
-	LogResponse("deleteSourceDeployScenario", resp)
+	LogResponseContext(ctx, "deleteSourceDeployScenario", resp)
 	d.SetId("")
 	return nil
 }
@@ -881,13 +881,13 @@ func changeDeployScenario(ctx context.Context, d *schema.ResourceData, config *c
 		return paramsErr
 	}
 
-	LogCommonRequest("changeSourceDeployScenario", reqParams)
+	LogCommonRequestContext(ctx, "changeSourceDeployScenario", reqParams)
 	resp, err := config.Client.Vsourcedeploy.V1Api.ChangeScenario(ctx, reqParams, projectId, stageId, ncloud.String(d.Id()))
 	if err != nil {
-		LogErrorResponse("changeSourceDeployScenario", err, reqParams)
+		LogErrorResponseContext(ctx, "changeSourceDeployScenario", err, reqParams)
 		return err
 	}
-	LogResponse("changeSourceDeployScenario", resp)
+	LogResponseContext(ctx, "changeSourceDeployScenario", resp)
 
 	return nil
 }
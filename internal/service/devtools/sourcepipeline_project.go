@@ -340,25 +340,25 @@ func GetPipelineProject(ctx context.Context, config *conn.ProviderConfig, id str
 }
 
 func getClassicPipelineProject(ctx context.Context, config *conn.ProviderConfig, projectId string) (*PipelineProject, error) {
-	LogCommonRequest("getSourcePipelineProject", projectId)
+	LogCommonRequestContext(ctx, "getSourcePipelineProject", projectId)
 	resp, err := config.Client.Sourcepipeline.V1Api.GetProject(ctx, &projectId)
 	if err != nil {
-		LogErrorResponse("getSourcePipelineProject", err, projectId)
+		LogErrorResponseContext(ctx, "getSourcePipelineProject", err, projectId)
 		return nil, err
 	}
-	LogResponse("getSourcePipelineProject", resp)
+	LogResponseContext(ctx, "getSourcePipelineProject", resp)
 
 	return convertClassicPipelineProject(resp), nil
 }
 
 func getVpcPipelineProject(ctx context.Context, config *conn.ProviderConfig, projectId string) (*PipelineProject, error) {
-	LogCommonRequest("getSourcePipelineProject", projectId)
+	LogCommonRequestContext(ctx, "getSourcePipelineProject", projectId)
 	resp, err := config.Client.Vsourcepipeline.V1Api.GetProject(ctx, &projectId)
 	if err != nil {
-		LogErrorResponse("getSourcePipelineProject", err, projectId)
+		LogErrorResponseContext(ctx, "getSourcePipelineProject", err, projectId)
 		return nil, err
 	}
-	LogResponse("getSourcePipelineProject", resp)
+	LogResponseContext(ctx, "getSourcePipelineProject", resp)
 
 	return convertVpcPipelineProject(resp), nil
 }
@@ -385,13 +385,13 @@ func updateClassicPipelineProject(ctx context.Context, d *schema.ResourceData, c
 		Trigger:     makeClassicPipelineTriggerParams(d),
 	}
 
-	LogCommonRequest("setSourcePipelineProject", reqParams)
+	LogCommonRequestContext(ctx, "setSourcePipelineProject", reqParams)
 	resp, err := config.Client.Sourcepipeline.V1Api.ChangeProject(ctx, reqParams, &projectId)
 	if err != nil {
-		LogErrorResponse("setSourcePipelineProject", err, projectId)
+		LogErrorResponseContext(ctx, "setSourcePipelineProject", err, projectId)
 		return diag.FromErr(err)
 	}
-	LogResponse("setSourcePipelineProject", resp)
+	LogResponseContext(ctx, "setSourcePipelineProject", resp)
 
 	return nil
 }
@@ -411,13 +411,13 @@ func updateVpcPipelineProject(ctx context.Context, d *schema.ResourceData, confi
 		Trigger:     makeVpcPipelineTriggerParams(d),
 	}
 
-	LogCommonRequest("setSourcePipelineProject", reqParams)
+	LogCommonRequestContext(ctx, "setSourcePipelineProject", reqParams)
 	resp, err := config.Client.Vsourcepipeline.V1Api.ChangeProject(ctx, reqParams, &projectId)
 	if err != nil {
-		LogErrorResponse("setSourcePipelineProject", err, projectId)
+		LogErrorResponseContext(ctx, "setSourcePipelineProject", err, projectId)
 		return diag.FromErr(err)
 	}
-	LogResponse("setSourcePipelineProject", resp)
+	LogResponseContext(ctx, "setSourcePipelineProject", resp)
 
 	return nil
 }
@@ -432,20 +432,20 @@ func deletePipelineProject(ctx context.Context, config *conn.ProviderConfig, id
 func deleteClassicPipelineProject(ctx context.Context, config *conn.ProviderConfig, projectId string) error {
 	resp, err := config.Client.Sourcepipeline.V1Api.DeleteProject(ctx, &projectId)
 	if err != nil {
-		LogErrorResponse("deleteSourcePipelineProject", err, projectId)
+		LogErrorResponseContext(ctx, "deleteSourcePipelineProject", err, projectId)
 		return err
 	}
-	LogResponse("deleteSourcePipelineProject", resp)
+	LogResponseContext(ctx, "deleteSourcePipelineProject", resp)
 	return nil
 }
 
 func deleteVpcPipelineProject(ctx context.Context, config *conn.ProviderConfig, projectId string) error {
 	resp, err := config.Client.Vsourcepipeline.V1Api.DeleteProject(ctx, &projectId)
 	if err != nil {
-		LogErrorResponse("deleteSourcePipelineProject", err, projectId)
+		LogErrorResponseContext(ctx, "deleteSourcePipelineProject", err, projectId)
 		return err
 	}
-	LogResponse("deleteSourcePipelineProject", resp)
+	LogResponseContext(ctx, "deleteSourcePipelineProject", resp)
 	return nil
 }
 
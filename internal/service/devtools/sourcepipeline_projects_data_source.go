@@ -44,10 +44,10 @@ func dataSourceNcloudSourcePipelineProjectsRead(ctx context.Context, d *schema.R
 
 	projects, err := getSourcePipelineProjects(ctx, config)
 	if err != nil {
-		LogErrorResponse("getSourcePipelineProjects", err, projects)
+		LogErrorResponseContext(ctx, "getSourcePipelineProjects", err, projects)
 		return diag.FromErr(err)
 	}
-	LogResponse("getSourcePipelineProjects", projects)
+	LogResponseContext(ctx, "getSourcePipelineProjects", projects)
 
 	if projects == nil {
 		d.SetId("")
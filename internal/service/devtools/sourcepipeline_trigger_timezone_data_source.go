@@ -35,10 +35,10 @@ func dataSourceNcloudSourcePipelineTimeZoneRead(ctx context.Context, d *schema.R
 
 	timeZone, err := getSourcePipelineTimeZone(ctx, config)
 	if err != nil {
-		LogErrorResponse("getSourcePipelineTimeZone", err, timeZone)
+		LogErrorResponseContext(ctx, "getSourcePipelineTimeZone", err, timeZone)
 		return diag.FromErr(err)
 	}
-	LogResponse("getSourcePipelineTimeZone", timeZone)
+	LogResponseContext(ctx, "getSourcePipelineTimeZone", timeZone)
 
 	if timeZone == nil {
 		d.SetId("")
@@ -27,7 +27,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -39,6 +38,7 @@ var (
 	_ resource.Resource                = &hadoopResource{}
 	_ resource.ResourceWithConfigure   = &hadoopResource{}
 	_ resource.ResourceWithImportState = &hadoopResource{}
+	_ resource.ResourceWithModifyPlan  = &hadoopResource{}
 )
 
 func NewHadoopResource() resource.Resource {
@@ -54,6 +54,22 @@ func (r *hadoopResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 func (r *hadoopResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	adminUserPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[A-Z]+`), "Must have at least one uppercase alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[\W_]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&\\"'/\s`+"`"+`]*$`), "Must not have ` & \\ \" ' / and white space."),
+	)
+
+	kdcPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[A-Z]+`), "Must have at least one uppercase alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": framework.IDAttribute(),
@@ -100,21 +116,26 @@ func (r *hadoopResource) Schema(_ context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"admin_user_password": schema.StringAttribute{
-				Required: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					framework.RequiresReplaceUnlessWriteOnly("admin_user_password_wo"),
 				},
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[A-Z]+`), "Must have at least one uppercase alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[\W_]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&\\"'/\s`+"`"+`]*$`), "Must not have ` & \\ \" ' / and white space."),
-					),
+					adminUserPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("admin_user_password_wo")),
 				},
 				Sensitive: true,
 			},
+			"admin_user_password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					adminUserPasswordValidator,
+				},
+				Description: "Write-only admin user password, which is never stored in the state. Use instead of `admin_user_password`.",
+			},
+			"admin_user_password_wo_version": framework.WriteOnlyVersionAttribute("admin_user_password_wo"),
 			"login_key_name": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -278,21 +299,29 @@ func (r *hadoopResource) Schema(_ context.Context, req resource.SchemaRequest, r
 				Optional:  true,
 				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					framework.RequiresReplaceUnlessWriteOnly("kdc_password_wo"),
 				},
 				Validators: []validator.String{
 					stringvalidator.AlsoRequires(path.Expressions{
 						path.MatchRoot("use_kdc"),
 					}...),
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[A-Z]+`), "Must have at least one uppercase alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					kdcPasswordValidator,
+					stringvalidator.ConflictsWith(path.MatchRoot("kdc_password_wo")),
 				},
 			},
+			"kdc_password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("use_kdc"),
+					}...),
+					kdcPasswordValidator,
+				},
+				Description: "Write-only kerberos KDC password, which is never stored in the state. Use instead of `kdc_password`.",
+			},
+			"kdc_password_wo_version": framework.WriteOnlyVersionAttribute("kdc_password_wo"),
 			"use_bootstrap_script": schema.BoolAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Bool{
@@ -445,13 +474,25 @@ func (r *hadoopResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	adminUserPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.AdminUserPassword, path.Root("admin_user_password_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kdcPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.KdcPassword, path.Root("kdc_password_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	reqParams := &vhadoop.CreateCloudHadoopInstanceRequest{
 		RegionCode:                    &r.config.RegionCode,
 		VpcNo:                         plan.VpcNo.ValueStringPointer(),
 		CloudHadoopClusterName:        plan.ClusterName.ValueStringPointer(),
 		CloudHadoopClusterTypeCode:    plan.ClusterTypeCode.ValueStringPointer(),
 		CloudHadoopAdminUserName:      plan.AdminUserName.ValueStringPointer(),
-		CloudHadoopAdminUserPassword:  plan.AdminUserPassword.ValueStringPointer(),
+		CloudHadoopAdminUserPassword:  adminUserPassword.ValueStringPointer(),
 		LoginKeyName:                  plan.LoginKey.ValueStringPointer(),
 		EdgeNodeSubnetNo:              plan.EdgeNodeSubnetNo.ValueStringPointer(),
 		MasterNodeSubnetNo:            plan.MasterNodeSubnetNo.ValueStringPointer(),
@@ -505,8 +546,8 @@ func (r *hadoopResource) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 
-		if !plan.KdcPassword.IsNull() {
-			reqParams.KdcPassword = plan.KdcPassword.ValueStringPointer()
+		if !kdcPassword.IsNull() {
+			reqParams.KdcPassword = kdcPassword.ValueStringPointer()
 		} else {
 			resp.Diagnostics.AddError(
 				"CREATING ERROR",
@@ -516,7 +557,7 @@ func (r *hadoopResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	} else {
 		kdcRealmHasValue := !plan.KdcRealm.IsNull() && !plan.KdcRealm.IsUnknown()
-		kdcPasswordHasValue := !plan.KdcPassword.IsNull() && !plan.KdcPassword.IsUnknown()
+		kdcPasswordHasValue := !kdcPassword.IsNull() && !kdcPassword.IsUnknown()
 		if kdcRealmHasValue || kdcPasswordHasValue {
 			resp.Diagnostics.AddError(
 				"CREATING ERROR",
@@ -551,14 +592,14 @@ func (r *hadoopResource) Create(ctx context.Context, req resource.CreateRequest,
 		reqParams.UseDataCatalog = plan.UseDataCatalog.ValueBoolPointer()
 	}
 
-	tflog.Info(ctx, "CreateHadoop reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateHadoop", reqParams)
 
 	response, err := r.config.Client.Vhadoop.V2Api.CreateCloudHadoopInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateHadoop response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateHadoop", response)
 
 	if response == nil || len(response.CloudHadoopInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -606,6 +647,12 @@ func (r *hadoopResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 }
 
+func (r *hadoopResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The API has no way to change the admin user or KDC password of an existing instance.
+	framework.DenyWriteOnlyVersionChange(ctx, req, resp, path.Root("admin_user_password"), path.Root("admin_user_password_wo_version"))
+	framework.DenyWriteOnlyVersionChange(ctx, req, resp, path.Root("kdc_password"), path.Root("kdc_password_wo_version"))
+}
+
 func (r *hadoopResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state hadoopResourceModel
 
@@ -622,14 +669,14 @@ func (r *hadoopResource) Update(ctx context.Context, req resource.UpdateRequest,
 			CloudHadoopInstanceNo: state.ID.ValueStringPointer(),
 			WorkerNodeCount:       ncloud.Int32(int32(plan.WorkerNodeCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeHadoopWorkerNodeCount reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "ChangeHadoopWorkerNodeCount", reqParams)
 
 		response, err := r.config.Client.Vhadoop.V2Api.ChangeCloudHadoopNodeCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeHadoopWorkerNodeCount response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "ChangeHadoopWorkerNodeCount", response)
 
 		if response == nil || len(response.CloudHadoopInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -664,14 +711,14 @@ func (r *hadoopResource) Update(ctx context.Context, req resource.UpdateRequest,
 		if !plan.WorkerNodeProductCode.Equal(state.WorkerNodeProductCode) {
 			reqParams.WorkerNodeProductCode = plan.WorkerNodeProductCode.ValueStringPointer()
 		}
-		tflog.Info(ctx, "ChangeHadoopNodeSpec reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "ChangeHadoopNodeSpec", reqParams)
 
 		response, err := r.config.Client.Vhadoop.V2Api.ChangeCloudHadoopNodeSpec(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeHadoopNodeSpec response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "ChangeHadoopNodeSpec", response)
 
 		if response == nil || len(response.CloudHadoopInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -687,6 +734,11 @@ func (r *hadoopResource) Update(ctx context.Context, req resource.UpdateRequest,
 		state.refreshFromOutput(ctx, output)
 	}
 
+	state.AdminUserPassword = plan.AdminUserPassword
+	state.AdminUserPasswordWoVersion = plan.AdminUserPasswordWoVersion
+	state.KdcPassword = plan.KdcPassword
+	state.KdcPasswordWoVersion = plan.KdcPasswordWoVersion
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -702,14 +754,14 @@ func (r *hadoopResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		RegionCode:            &r.config.RegionCode,
 		CloudHadoopInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteHadoop reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteHadoop", reqParams)
 
 	response, err := r.config.Client.Vhadoop.V2Api.DeleteCloudHadoopInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteHadoop response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteHadoop", response)
 
 	if err := waitHadoopDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -725,14 +777,14 @@ func GetHadoopInstance(ctx context.Context, config *conn.ProviderConfig, id stri
 		RegionCode:            &config.RegionCode,
 		CloudHadoopInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetHadoopDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetHadoopDetail", reqParams)
 
 	resp, err := config.Client.Vhadoop.V2Api.GetCloudHadoopInstanceDetail(reqParams)
 	// If the lookup result is 0 or already deleted, it will respond with a 400 error with a 5001017 return code.
 	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetHadoopDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetHadoopDetail", resp)
 
 	if resp == nil || len(resp.CloudHadoopInstanceList) < 1 || len(resp.CloudHadoopInstanceList[0].CloudHadoopServerInstanceList) < 1 {
 		return nil, nil
@@ -857,6 +909,8 @@ type hadoopResourceModel struct {
 	ClusterTypeCode            types.String `tfsdk:"cluster_type_code"`
 	AdminUserName              types.String `tfsdk:"admin_user_name"`
 	AdminUserPassword          types.String `tfsdk:"admin_user_password"`
+	AdminUserPasswordWo        types.String `tfsdk:"admin_user_password_wo"`
+	AdminUserPasswordWoVersion types.Int64  `tfsdk:"admin_user_password_wo_version"`
 	LoginKey                   types.String `tfsdk:"login_key_name"`
 	EdgeNodeSubnetNo           types.String `tfsdk:"edge_node_subnet_no"`
 	MasterNodeSubnetNo         types.String `tfsdk:"master_node_subnet_no"`
@@ -876,6 +930,8 @@ type hadoopResourceModel struct {
 	UseKdc                     types.Bool   `tfsdk:"use_kdc"`
 	KdcRealm                   types.String `tfsdk:"kdc_realm"`
 	KdcPassword                types.String `tfsdk:"kdc_password"`
+	KdcPasswordWo              types.String `tfsdk:"kdc_password_wo"`
+	KdcPasswordWoVersion       types.Int64  `tfsdk:"kdc_password_wo_version"`
 	UseBootstrapScript         types.Bool   `tfsdk:"use_bootstrap_script"`
 	BootstrapScript            types.String `tfsdk:"bootstrap_script"`
 	UseDataCatalog             types.Bool   `tfsdk:"use_data_catalog"`
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -112,14 +111,14 @@ func (h *hadoopImageProductsDataSource) Read(ctx context.Context, req datasource
 	reqParams := &vhadoop.GetCloudHadoopImageProductListRequest{
 		RegionCode: &h.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetHadoopImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetHadoopImageProductList", reqParams)
 
 	imageProductResp, err := h.config.Client.Vhadoop.V2Api.GetCloudHadoopImageProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetHadoopimageProductList response="+common.MarshalUncheckedString(imageProductResp))
+	common.LogResponseContext(ctx, "GetHadoopimageProductList", imageProductResp)
 
 	if imageProductResp == nil || len(imageProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
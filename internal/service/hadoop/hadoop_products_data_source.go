@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -131,7 +130,7 @@ func (h *hadoopProductsDataSource) Read(ctx context.Context, req datasource.Read
 		reqParams.InfraResourceDetailTypeCode = data.InfraResourceDetailTypeCode.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetHadoopProductsList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetHadoopProductsList", reqParams)
 
 	hadoopProductsResp, err := h.config.Client.Vhadoop.V2Api.GetCloudHadoopProductList(reqParams)
 	if err != nil {
@@ -139,7 +138,7 @@ func (h *hadoopProductsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	tflog.Info(ctx, "GetHadoopProductsList response="+common.MarshalUncheckedString(hadoopProductsResp))
+	common.LogResponseContext(ctx, "GetHadoopProductsList", hadoopProductsResp)
 
 	if hadoopProductsResp == nil || len(hadoopProductsResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -19,7 +19,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -262,17 +261,17 @@ func (l *lbResource) Create(ctx context.Context, req resource.CreateRequest, res
 
 	reqParams.VpcNo = subnetList[0].VpcNo
 
-	LogCommonRequest("createLoadBalancerInstance", reqParams)
+	LogCommonRequestContext(ctx, "createLoadBalancerInstance", reqParams)
 	createResp, err := l.config.Client.Vloadbalancer.V2Api.CreateLoadBalancerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("createLoadBalancerInstance", err, reqParams)
+		LogErrorResponseContext(ctx, "createLoadBalancerInstance", err, reqParams)
 		resp.Diagnostics.AddError(
 			"Error creating load balancer instance",
 			err.Error(),
 		)
 		return
 	}
-	LogResponse("createLoadBalancerInstance", createResp)
+	LogResponseContext(ctx, "createLoadBalancerInstance", createResp)
 
 	if err := waitForLoadBalancerActive(ctx, l.config, ncloud.StringValue(createResp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo)); err != nil {
 		resp.Diagnostics.AddError(
@@ -450,7 +449,7 @@ func (l *lbResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		LoadBalancerInstanceNoList: []*string{ncloud.String(state.LoadBalancerNo.ValueString())},
 	}
 
-	tflog.Info(ctx, "DeleteLoadBalancer reqParams="+MarshalUncheckedString(reqParams))
+	LogCommonRequestContext(ctx, "DeleteLoadBalancer", reqParams)
 
 	if err := waitForLoadBalancerActive(ctx, l.config, state.LoadBalancerNo.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAIT FOR LOADBALANCER ERROR", err.Error())
@@ -462,7 +461,7 @@ func (l *lbResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteLoadBalancer response="+MarshalUncheckedString(response))
+	LogResponseContext(ctx, "DeleteLoadBalancer", response)
 
 	if err := waitForLoadBalancerDeletion(ctx, l.config, state.LoadBalancerNo.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -475,14 +474,14 @@ func GetFwVpcLoadBalancer(ctx context.Context, config *conn.ProviderConfig, id s
 		RegionCode:             &config.RegionCode,
 		LoadBalancerInstanceNo: ncloud.String(id),
 	}
-	LogCommonRequest("getLoadBalancerInstanceDetail", reqParams)
+	LogCommonRequestContext(ctx, "getLoadBalancerInstanceDetail", reqParams)
 
 	resp, err := config.Client.Vloadbalancer.V2Api.GetLoadBalancerInstanceDetail(reqParams)
 	if err != nil {
-		LogErrorResponse("getLoadBalancerInstanceDetail", err, reqParams)
+		LogErrorResponseContext(ctx, "getLoadBalancerInstanceDetail", err, reqParams)
 		return nil, err
 	}
-	LogResponse("getLoadBalancerInstanceDetail", resp)
+	LogResponseContext(ctx, "getLoadBalancerInstanceDetail", resp)
 
 	if len(resp.LoadBalancerInstanceList) < 1 {
 		return nil, nil
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -155,21 +154,17 @@ func (l *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRe
 		reqParams.LoadBalancerInstanceNoList = []*string{data.ID.ValueStringPointer()}
 	}
 
-	tflog.Info(ctx, "GetLoadBalancerInstanceList", map[string]any{
-		"reqParams": MarshalUncheckedString(reqParams),
-	})
+	LogCommonRequestContext(ctx, "GetLoadBalancerInstanceList", reqParams)
 	lbResp, err := l.config.Client.Vloadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"GetLoadBalancerInstanceList",
-			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), MarshalUncheckedString(reqParams)),
+			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), RedactLogValue(reqParams)),
 		)
 		return
 	}
-	tflog.Info(ctx, "GetLoadBalancerInstanceList response", map[string]any{
-		"lbResponse": MarshalUncheckedString(lbResp),
-	})
+	LogResponseContext(ctx, "GetLoadBalancerInstanceList", lbResp)
 
 	lbList, diags := flattenLoadBalancers(ctx, lbResp.LoadBalancerInstanceList)
 	resp.Diagnostics.Append(diags...)
@@ -193,11 +193,11 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	LogCommonRequest("resourceNcloudTargetGroupCreate", reqParams)
+	LogCommonRequestContext(ctx, "resourceNcloudTargetGroupCreate", reqParams)
 	resp, err := config.Client.Vloadbalancer.V2Api.CreateTargetGroup(reqParams)
-	LogResponse("resourceNcloudTargetGroupCreate", resp)
+	LogResponseContext(ctx, "resourceNcloudTargetGroupCreate", resp)
 	if err != nil {
-		LogErrorResponse("resourceNcloudTargetGroupCreate", err, reqParams)
+		LogErrorResponseContext(ctx, "resourceNcloudTargetGroupCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
 
@@ -254,9 +254,9 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 				reqParams.HealthCheckHttpMethodTypeCode = ncloud.String(healthCheck["http_method"].(string))
 			}
 		}
-		LogCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
+		LogCommonRequestContext(ctx, "resourceNcloudTargetGroupUpdate", reqParams)
 		if _, err := config.Client.Vloadbalancer.V2Api.ChangeTargetGroupHealthCheckConfiguration(reqParams); err != nil {
-			LogErrorResponse("resourceNcloudTargetGroupUpdate", err, reqParams)
+			LogErrorResponseContext(ctx, "resourceNcloudTargetGroupUpdate", err, reqParams)
 			return diag.FromErr(err)
 		}
 	}
@@ -279,7 +279,7 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 		if err := validateAlgorithmTypeByTargetGroupProtocol(*reqParams.AlgorithmTypeCode, targetGroupProtocol); err != nil {
 			return diag.FromErr(err)
 		}
-		LogCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
+		LogCommonRequestContext(ctx, "resourceNcloudTargetGroupUpdate", reqParams)
 		if _, err := config.Client.Vloadbalancer.V2Api.ChangeTargetGroupConfiguration(reqParams); err != nil {
 			return diag.FromErr(err)
 		}
@@ -218,35 +218,35 @@ func getMatchTargetNoListFromResponse(respTargetList []*vloadbalancer.Target, ta
 
 func waitForAddTarget(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, reqParams *vloadbalancer.AddTargetRequest) error {
 	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		LogCommonRequest("resourceNcloudLbTargetGroupAttachmentCreate", reqParams)
+		LogCommonRequestContext(ctx, "resourceNcloudLbTargetGroupAttachmentCreate", reqParams)
 		resp, err := config.Client.Vloadbalancer.V2Api.AddTarget(reqParams)
 		if err != nil {
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == TargetGroupAttachmentBusyStateErrorCode || errBody.ReturnCode == TargetGroupAttachmentPleaseTryAgainErrorCode {
 				return resource.RetryableError(err)
 			}
-			LogErrorResponse("resourceNcloudLbTargetGroupAttachmentCreate", err, reqParams)
+			LogErrorResponseContext(ctx, "resourceNcloudLbTargetGroupAttachmentCreate", err, reqParams)
 			return resource.NonRetryableError(err)
 		}
 
-		LogResponse("resourceNcloudLbTargetGroupAttachmentCreate", resp)
+		LogResponseContext(ctx, "resourceNcloudLbTargetGroupAttachmentCreate", resp)
 		return nil
 	})
 }
 
 func waitForRemoveTarget(ctx context.Context, d *schema.ResourceData, config *conn.ProviderConfig, reqParams *vloadbalancer.RemoveTargetRequest) error {
 	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		LogCommonRequest("resourceNcloudLbTargetGroupAttachmentDelete", reqParams)
+		LogCommonRequestContext(ctx, "resourceNcloudLbTargetGroupAttachmentDelete", reqParams)
 		resp, err := config.Client.Vloadbalancer.V2Api.RemoveTarget(reqParams)
 		if err != nil {
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == TargetGroupAttachmentBusyStateErrorCode || errBody.ReturnCode == TargetGroupAttachmentPleaseTryAgainErrorCode {
 				return resource.RetryableError(err)
 			}
-			LogErrorResponse("resourceNcloudLbTargetGroupAttachmentDelete", err, reqParams)
+			LogErrorResponseContext(ctx, "resourceNcloudLbTargetGroupAttachmentDelete", err, reqParams)
 			return resource.NonRetryableError(err)
 		}
-		LogResponse("resourceNcloudLbTargetGroupAttachmentDelete", resp)
+		LogResponseContext(ctx, "resourceNcloudLbTargetGroupAttachmentDelete", resp)
 
 		matchTargetNoList := getMatchTargetNoListFromResponse(resp.TargetList, ncloud.StringListValue(reqParams.TargetNoList))
 		if len(matchTargetNoList) > 0 {
@@ -12,7 +12,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -50,6 +49,14 @@ func (m *mongodbResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 func (m *mongodbResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"service_name": schema.StringAttribute{
@@ -93,22 +100,24 @@ func (m *mongodbResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Access username, which will be used for DB admin.",
 			},
 			"user_password": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					userPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_password_wo")),
 				},
 				Description: "Access password for user, which will be used for DB admin.",
 				Sensitive:   true,
 			},
+			"user_password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					userPasswordValidator,
+				},
+				Description: "Write-only access password for user, which is never stored in the state. Use instead of `user_password`.",
+			},
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo"),
 			"vpc_no": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -418,12 +427,18 @@ func (m *mongodbResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	userPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.UserPassword, path.Root("user_password_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	reqParams := &vmongodb.CreateCloudMongoDbInstanceRequest{
 		RegionCode:                   &m.config.RegionCode,
 		CloudMongoDbServiceName:      plan.ServiceName.ValueStringPointer(),
 		CloudMongoDbServerNamePrefix: plan.ServerNamePrefix.ValueStringPointer(),
 		CloudMongoDbUserName:         plan.UserName.ValueStringPointer(),
-		CloudMongoDbUserPassword:     plan.UserPassword.ValueStringPointer(),
+		CloudMongoDbUserPassword:     userPassword.ValueStringPointer(),
 		VpcNo:                        plan.VpcNo.ValueStringPointer(),
 		SubnetNo:                     plan.SubnetNo.ValueStringPointer(),
 		ClusterTypeCode:              plan.ClusterTypeCode.ValueStringPointer(),
@@ -568,14 +583,14 @@ func (m *mongodbResource) Create(ctx context.Context, req resource.CreateRequest
 		reqParams.DataStorageTypeCode = plan.DataStorageType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateMongoDb reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateMongoDb", reqParams)
 
 	response, err := m.config.Client.Vmongodb.V2Api.CreateCloudMongoDbInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMongoDb response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateMongoDb", response)
 
 	if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -639,14 +654,14 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 			CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 			ConfigServerCount:      ncloud.Int32(int32(plan.ConfigServerCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbConfigCount reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "ChangeCloudMongoDbConfigCount", reqParams)
 
 		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbConfigCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbConfigCount response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "ChangeCloudMongoDbConfigCount", response)
 
 		if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -670,14 +685,14 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 			CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 			MongosServerCount:      ncloud.Int32(int32(plan.MongosServerCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbMongosCount reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "ChangeCloudMongoDbMongosCount", reqParams)
 
 		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbMongosCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbMongosCount response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "ChangeCloudMongoDbMongosCount", response)
 
 		if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -703,14 +718,14 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 			MemberServerCount:      ncloud.Int32(int32(plan.MemberServerCount.ValueInt64())),
 			ArbiterServerCount:     ncloud.Int32(int32(plan.ArbiterServerCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbSecondaryCount reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "ChangeCloudMongoDbSecondaryCount", reqParams)
 
 		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbSecondaryCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbSecondaryCount response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "ChangeCloudMongoDbSecondaryCount", response)
 
 		if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -734,14 +749,14 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 			CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 			ShardCount:             ncloud.Int32(int32(plan.ShardCount.ValueInt64())),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbShardCount reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "ChangeCloudMongoDbShardCount", reqParams)
 
 		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbShardCount(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbShardCount response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "ChangeCloudMongoDbShardCount", response)
 
 		if response == nil || len(response.CloudMongoDbInstanceList) < 1 {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -759,6 +774,59 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 		state.refreshFromOutput(ctx, output)
 	}
 
+	if !plan.UserPassword.Equal(state.UserPassword) || !plan.UserPasswordWoVersion.Equal(state.UserPasswordWoVersion) {
+		userPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.UserPassword, path.Root("user_password_wo"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		users, err := GetMongoDbUserList(ctx, m.config, state.ID.ValueString(), []string{state.UserName.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("READING ERROR", err.Error())
+			return
+		}
+
+		if len(users) < 1 || ncloud.StringValue(users[0].UserName) != state.UserName.ValueString() {
+			resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("user %s not found", state.UserName.ValueString()))
+			return
+		}
+
+		reqParams := &vmongodb.ChangeCloudMongoDbUserListRequest{
+			RegionCode:             &m.config.RegionCode,
+			CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
+			CloudMongoDbUserList: []*vmongodb.AddOrChangeCloudMongoDbUserParameter{
+				{
+					UserName:     users[0].UserName,
+					DatabaseName: users[0].DatabaseName,
+					Password:     userPassword.ValueStringPointer(),
+					Authority:    users[0].Authority,
+				},
+			},
+		}
+		common.LogCommonRequestContext(ctx, "ChangeCloudMongoDbUserList", reqParams)
+
+		response, err := m.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbUserList(reqParams)
+		if err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+		common.LogResponseContext(ctx, "ChangeCloudMongoDbUserList", response)
+
+		if response == nil || *response.ReturnCode != "0" {
+			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
+			return
+		}
+
+		if _, err := waitMongoDbUpdate(ctx, m.config, state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("WAITING FOR UPDATE ERROR", err.Error())
+			return
+		}
+	}
+
+	state.UserPassword = plan.UserPassword
+	state.UserPasswordWoVersion = plan.UserPasswordWoVersion
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -774,14 +842,14 @@ func (m *mongodbResource) Delete(ctx context.Context, req resource.DeleteRequest
 		RegionCode:             &m.config.RegionCode,
 		CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMongoDb reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteMongoDb", reqParams)
 
 	response, err := m.config.Client.Vmongodb.V2Api.DeleteCloudMongoDbInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMongoDb response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteMongoDb", response)
 
 	if err := waitMongoDbDeleted(ctx, m.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -797,14 +865,14 @@ func GetCloudMongoDbInstance(ctx context.Context, config *conn.ProviderConfig, n
 		RegionCode:             &config.RegionCode,
 		CloudMongoDbInstanceNo: ncloud.String(no),
 	}
-	tflog.Info(ctx, "GetMongoDbDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMongoDbDetail", reqParams)
 
 	resp, err := config.Client.Vmongodb.V2Api.GetCloudMongoDbInstanceDetail(reqParams)
 	// If the lookup result is 0 or already deleted, it will respond with a 400 error with a 5001017 return code.
 	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMongoDbDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetMongoDbDetail", resp)
 
 	if resp == nil || len(resp.CloudMongoDbInstanceList) < 1 || len(resp.CloudMongoDbInstanceList[0].CloudMongoDbServerInstanceList) < 1 {
 		return nil, nil
@@ -944,6 +1012,8 @@ type mongodbResourceModel struct {
 	ServerNamePrefix          types.String `tfsdk:"server_name_prefix"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	ClusterTypeCode           types.String `tfsdk:"cluster_type_code"`
 	ImageProductCode          types.String `tfsdk:"image_product_code"`
 	MemberProductCode         types.String `tfsdk:"member_product_code"`
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -114,14 +113,14 @@ func (m *mongodbImageProductsDataSource) Read(ctx context.Context, req datasourc
 	reqParams := &vmongodb.GetCloudMongoDbImageProductListRequest{
 		RegionCode: &m.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetMongoDbImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMongoDbImageProductList", reqParams)
 
 	mongodbImageProductResp, err := m.config.Client.Vmongodb.V2Api.GetCloudMongoDbImageProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMongoDbImageProductList response="+common.MarshalUncheckedString(mongodbImageProductResp))
+	common.LogResponseContext(ctx, "GetMongoDbImageProductList", mongodbImageProductResp)
 
 	if mongodbImageProductResp == nil || len(mongodbImageProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
@@ -163,14 +162,14 @@ func (r *mongodbUsersResource) Create(ctx context.Context, req resource.CreateRe
 
 	plan.ID = plan.MongoDbInstanceNo
 
-	tflog.Info(ctx, "CreateMongodbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateMongodbUserList", reqParams)
 
 	response, err := r.config.Client.Vmongodb.V2Api.AddCloudMongoDbUserList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMongodbUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateMongodbUserList", response)
 
 	if response == nil || *response.ReturnCode != "0" {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -246,14 +245,14 @@ func (r *mongodbUsersResource) Update(ctx context.Context, req resource.UpdateRe
 			CloudMongoDbInstanceNo: state.ID.ValueStringPointer(),
 			CloudMongoDbUserList:   convertToCloudMongodbUserParameter(plan.MongoDbUserList),
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "ChangeCloudMongoDbUserList", reqParams)
 
 		response, err := r.config.Client.Vmongodb.V2Api.ChangeCloudMongoDbUserList(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMongoDbUserList response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "ChangeCloudMongoDbUserList", response)
 
 		if response == nil || *response.ReturnCode != "0" {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -300,14 +299,14 @@ func (r *mongodbUsersResource) Delete(ctx context.Context, req resource.DeleteRe
 		CloudMongoDbInstanceNo: state.MongoDbInstanceNo.ValueStringPointer(),
 		CloudMongoDbUserList:   convertToCloudMongodbUser(state.MongoDbUserList),
 	}
-	tflog.Info(ctx, "DeleteMongodbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteMongodbUserList", reqParams)
 
 	response, err := r.config.Client.Vmongodb.V2Api.DeleteCloudMongoDbUserList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMongodbUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteMongodbUserList", response)
 }
 
 func GetMongoDbUserList(ctx context.Context, config *conn.ProviderConfig, id string, users []string) ([]*vmongodb.CloudMongoDbUser, error) {
@@ -315,7 +314,7 @@ func GetMongoDbUserList(ctx context.Context, config *conn.ProviderConfig, id str
 		RegionCode:             &config.RegionCode,
 		CloudMongoDbInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetMongodbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMongodbUserList", reqParams)
 
 	resp, err := config.Client.Vmongodb.V2Api.GetCloudMongoDbUserList(reqParams)
 	if err != nil {
@@ -352,7 +351,7 @@ func GetMongoDbUserList(ctx context.Context, config *conn.ProviderConfig, id str
 		}
 	}
 
-	tflog.Info(ctx, "GetMongodbUserList response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetMongodbUserList", resp)
 
 	return filteredUsers, nil
 }
@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -166,7 +165,7 @@ func GetMongoDbUserAllList(ctx context.Context, config *conn.ProviderConfig, id
 		RegionCode:             &config.RegionCode,
 		CloudMongoDbInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetMongodbUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMongodbUserList", reqParams)
 
 	resp, err := config.Client.Vmongodb.V2Api.GetCloudMongoDbUserList(reqParams)
 	if err != nil {
@@ -177,7 +176,7 @@ func GetMongoDbUserAllList(ctx context.Context, config *conn.ProviderConfig, id
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetMongodbUserList response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetMongodbUserList", resp)
 
 	return common.ReverseList(resp.CloudMongoDbUserList), nil
 }
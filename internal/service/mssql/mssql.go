@@ -26,7 +26,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -39,6 +38,7 @@ var (
 	_ resource.Resource                = &mssqlResource{}
 	_ resource.ResourceWithConfigure   = &mssqlResource{}
 	_ resource.ResourceWithImportState = &mssqlResource{}
+	_ resource.ResourceWithModifyPlan  = &mssqlResource{}
 )
 
 func NewMssqlResource() resource.Resource {
@@ -54,6 +54,14 @@ func (m *mssqlResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (m *mssqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": framework.IDAttribute(),
@@ -97,21 +105,26 @@ func (m *mssqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"user_password": schema.StringAttribute{
-				Required: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					framework.RequiresReplaceUnlessWriteOnly("user_password_wo"),
 				},
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					userPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_password_wo")),
 				},
 				Sensitive: true,
 			},
+			"user_password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					userPasswordValidator,
+				},
+				Description: "Write-only access password for user, which is never stored in the state. Use instead of `user_password`.",
+			},
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo"),
 			"config_group_no": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -315,6 +328,12 @@ func (r *mssqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	userPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.UserPassword, path.Root("user_password_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnet, err := vpc.GetSubnetInstance(r.config, plan.SubnetNo.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -329,7 +348,7 @@ func (r *mssqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		SubnetNo:                  subnet.SubnetNo,
 		CloudMssqlServiceName:     plan.ServiceName.ValueStringPointer(),
 		CloudMssqlUserName:        plan.UserName.ValueStringPointer(),
-		CloudMssqlUserPassword:    plan.UserPassword.ValueStringPointer(),
+		CloudMssqlUserPassword:    userPassword.ValueStringPointer(),
 		IsHa:                      plan.IsHa.ValueBoolPointer(),
 		ConfigGroupNo:             plan.ConfigGroupNo.ValueStringPointer(),
 		BackupFileRetentionPeriod: ncloud.Int32(int32(plan.BackupFileRetentionPeriod.ValueInt64())),
@@ -373,14 +392,14 @@ func (r *mssqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		reqParams.BackupTime = plan.BackupTime.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateMssql reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateMssql", reqParams)
 
 	response, err := r.config.Client.Vmssql.V2Api.CreateCloudMssqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMssql response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateMssql", response)
 
 	if response == nil || len(response.CloudMssqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -428,7 +447,26 @@ func (r *mssqlResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 }
 
-func (m *mssqlResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (m *mssqlResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The API has no way to change the user password of an existing instance.
+	framework.DenyWriteOnlyVersionChange(ctx, req, resp, path.Root("user_password"), path.Root("user_password_wo_version"))
+}
+
+// Update only records moving the user password to `user_password_wo`, as every other change requires replacement.
+func (m *mssqlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mssqlResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.UserPassword = plan.UserPassword
+	state.UserPasswordWoVersion = plan.UserPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *mssqlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -443,14 +481,14 @@ func (r *mssqlResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		RegionCode:           &r.config.RegionCode,
 		CloudMssqlInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMssql reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteMssql", reqParams)
 
 	response, err := r.config.Client.Vmssql.V2Api.DeleteCloudMssqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMssql response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteMssql", response)
 
 	if err := waitMssqlDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -466,7 +504,7 @@ func GetMssqlInstance(ctx context.Context, config *conn.ProviderConfig, no strin
 		RegionCode:           &config.RegionCode,
 		CloudMssqlInstanceNo: &no,
 	}
-	tflog.Info(ctx, "GetMssqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMssqlDetail", reqParams)
 
 	resp, err := config.Client.Vmssql.V2Api.GetCloudMssqlInstanceDetail(reqParams)
 	// If the lookup result is 0, it will respond with a 400 error with a 5001017 return code.
@@ -474,7 +512,7 @@ func GetMssqlInstance(ctx context.Context, config *conn.ProviderConfig, no strin
 	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) && !strings.Contains(err.Error(), `"returnCode": "5001269"`) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMssqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetMssqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMssqlInstanceList) < 1 || len(resp.CloudMssqlInstanceList[0].CloudMssqlServerInstanceList) < 1 {
 		return nil, nil
@@ -566,6 +604,8 @@ type mssqlResourceModel struct {
 	IsHa                      types.Bool   `tfsdk:"is_ha"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	ConfigGroupNo             types.String `tfsdk:"config_group_no"`
 	ImageProductCode          types.String `tfsdk:"image_product_code"`
 	ProductCode               types.String `tfsdk:"product_code"`
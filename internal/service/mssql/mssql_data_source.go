@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -192,14 +191,14 @@ func (m *mssqlDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			RegionCode:            &m.config.RegionCode,
 			CloudMssqlServiceName: data.ServiceName.ValueStringPointer(),
 		}
-		tflog.Info(ctx, "GetMssqlList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "GetMssqlList", reqParams)
 
 		listResp, err := m.config.Client.Vmssql.V2Api.GetCloudMssqlInstanceList(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("READING ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "GetMssqlList response="+common.MarshalUncheckedString(listResp))
+		common.LogResponseContext(ctx, "GetMssqlList", listResp)
 
 		if listResp == nil || len(listResp.CloudMssqlInstanceList) < 1 {
 			resp.Diagnostics.AddError("READING ERROR", "no result. please change search criteria and try again.")
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -121,14 +120,14 @@ func (m *mssqlProductsDataSource) Read(ctx context.Context, req datasource.ReadR
 		RegionCode:                 &m.config.RegionCode,
 		CloudMssqlImageProductCode: data.CloudMssqlImageProductCode.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "GetMssqlProductsList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMssqlProductsList", reqParams)
 
 	mssqlProductResp, err := m.config.Client.Vmssql.V2Api.GetCloudMssqlProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMssqlProductsList response="+common.MarshalUncheckedString(mssqlProductResp))
+	common.LogResponseContext(ctx, "GetMssqlProductsList", mssqlProductResp)
 
 	if mssqlProductResp == nil || len(mssqlProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
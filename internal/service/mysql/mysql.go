@@ -24,7 +24,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -52,6 +51,14 @@ func (m *mysqlResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"service_name": schema.StringAttribute{
@@ -95,21 +102,23 @@ func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"user_password": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					userPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_password_wo")),
 				},
 				Sensitive: true,
 			},
+			"user_password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					userPasswordValidator,
+				},
+				Description: "Write-only access password for user, which is never stored in the state. Use instead of `user_password`.",
+			},
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo"),
 			"host_ip": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -362,6 +371,12 @@ func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	userPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.UserPassword, path.Root("user_password_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnet, err := vpc.GetSubnetInstance(r.config, plan.SubnetNo.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -375,7 +390,7 @@ func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		CloudMysqlServiceName:      plan.ServiceName.ValueStringPointer(),
 		CloudMysqlServerNamePrefix: plan.ServerNamePrefix.ValueStringPointer(),
 		CloudMysqlUserName:         plan.UserName.ValueStringPointer(),
-		CloudMysqlUserPassword:     plan.UserPassword.ValueStringPointer(),
+		CloudMysqlUserPassword:     userPassword.ValueStringPointer(),
 		HostIp:                     plan.HostIp.ValueStringPointer(),
 		CloudMysqlDatabaseName:     plan.DatabaseName.ValueStringPointer(),
 		VpcNo:                      subnet.VpcNo,
@@ -503,14 +518,14 @@ func (r *mysqlResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
-	tflog.Info(ctx, "CreateMysql reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateMysql", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.CreateCloudMysqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMysql response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateMysql", response)
 
 	if response == nil || len(response.CloudMysqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -558,7 +573,72 @@ func (r *mysqlResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 }
 
-func (m *mysqlResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+// Update only changes the user password, as every other change requires replacement.
+func (r *mysqlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mysqlResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.UserPassword.Equal(state.UserPassword) || !plan.UserPasswordWoVersion.Equal(state.UserPasswordWoVersion) {
+		userPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.UserPassword, path.Root("user_password_wo"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		users, err := GetMysqlUserList(ctx, r.config, state.ID.ValueString(), []string{state.UserName.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("READING ERROR", err.Error())
+			return
+		}
+
+		if len(users) < 1 {
+			resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("user %s not found", state.UserName.ValueString()))
+			return
+		}
+
+		reqParams := &vmysql.ChangeCloudMysqlUserListRequest{
+			RegionCode:           &r.config.RegionCode,
+			CloudMysqlInstanceNo: state.ID.ValueStringPointer(),
+			CloudMysqlUserList: []*vmysql.CloudMysqlUserParameter{
+				{
+					Name:                users[0].UserName,
+					HostIp:              users[0].HostIp,
+					Password:            userPassword.ValueStringPointer(),
+					Authority:           users[0].Authority,
+					IsSystemTableAccess: users[0].IsSystemTableAccess,
+				},
+			},
+		}
+		common.LogCommonRequestContext(ctx, "ChangeCloudMysqlUserList", reqParams)
+
+		response, err := r.config.Client.Vmysql.V2Api.ChangeCloudMysqlUserList(reqParams)
+		if err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+		common.LogResponseContext(ctx, "ChangeCloudMysqlUserList", response)
+
+		if response == nil || *response.ReturnCode != "0" {
+			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
+			return
+		}
+
+		if _, err := waitMysqlCreation(ctx, r.config, state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("WAITING FOR UPDATE ERROR", err.Error())
+			return
+		}
+	}
+
+	state.UserPassword = plan.UserPassword
+	state.UserPasswordWoVersion = plan.UserPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *mysqlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -573,14 +653,14 @@ func (r *mysqlResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		RegionCode:           &r.config.RegionCode,
 		CloudMysqlInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMysql reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteMysql", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMysql response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteMysql", response)
 
 	if err := waitMysqlDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -596,13 +676,13 @@ func GetMysqlInstance(ctx context.Context, config *conn.ProviderConfig, no strin
 		RegionCode:           &config.RegionCode,
 		CloudMysqlInstanceNo: ncloud.String(no),
 	}
-	tflog.Info(ctx, "GetMysqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMysqlDetail", reqParams)
 
 	resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(reqParams)
 	if err != nil && !CheckIfAlreadyDeleted(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMysqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetMysqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMysqlInstanceList) < 1 || len(resp.CloudMysqlInstanceList[0].CloudMysqlServerInstanceList) < 1 {
 		return nil, nil
@@ -693,6 +773,8 @@ type mysqlResourceModel struct {
 	ServerNamePrefix          types.String `tfsdk:"server_name_prefix"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	HostIp                    types.String `tfsdk:"host_ip"`
 	DatabaseName              types.String `tfsdk:"database_name"`
 	SubnetNo                  types.String `tfsdk:"subnet_no"`
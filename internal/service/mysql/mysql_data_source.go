@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -199,14 +198,14 @@ func (m *mysqlDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			RegionCode:            &m.config.RegionCode,
 			CloudMysqlServiceName: data.ServiceName.ValueStringPointer(),
 		}
-		tflog.Info(ctx, "GetMysqlList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "GetMysqlList", reqParams)
 
 		listResp, err := m.config.Client.Vmysql.V2Api.GetCloudMysqlInstanceList(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("READING ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "GetMysqlList response="+common.MarshalUncheckedString(listResp))
+		common.LogResponseContext(ctx, "GetMysqlList", listResp)
 
 		if listResp == nil || len(listResp.CloudMysqlInstanceList) < 1 {
 			resp.Diagnostics.AddError("READING ERROR", "no result. please change search criteria and try again.")
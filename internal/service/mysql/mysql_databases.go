@@ -19,7 +19,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
@@ -126,14 +125,14 @@ func (r *mysqlDatabasesResource) Create(ctx context.Context, req resource.Create
 
 	plan.ID = plan.MysqlInstanceNo
 
-	tflog.Info(ctx, "CreateMysqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateMysqlDatabaseList", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.AddCloudMysqlDatabaseList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMysqlDatabaseList response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateMysqlDatabaseList", response)
 
 	if response == nil || *response.ReturnCode != "0" {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -209,14 +208,14 @@ func (r *mysqlDatabasesResource) Delete(ctx context.Context, req resource.Delete
 		CloudMysqlInstanceNo:       state.MysqlInstanceNo.ValueStringPointer(),
 		CloudMysqlDatabaseNameList: convertToStringList(state.MysqlDatabaseList),
 	}
-	tflog.Info(ctx, "DeleteMysqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteMysqlDatabaseList", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlDatabaseList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMysqlDatabaseList response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteMysqlDatabaseList", response)
 }
 
 func GetMysqlDatabaseList(ctx context.Context, config *conn.ProviderConfig, id string, dbs []string) ([]*vmysql.CloudMysqlDatabase, error) {
@@ -232,7 +231,7 @@ func GetMysqlDatabaseList(ctx context.Context, config *conn.ProviderConfig, id s
 			PageNo:               ncloud.Int32(pageNo),
 			PageSize:             ncloud.Int32(pageSize),
 		}
-		tflog.Info(ctx, "GetMysqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "GetMysqlDatabaseList", reqParams)
 
 		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlDatabaseList(reqParams)
 		if err != nil {
@@ -267,7 +266,7 @@ func GetMysqlDatabaseList(ctx context.Context, config *conn.ProviderConfig, id s
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetMysqlUserList response="+common.MarshalUncheckedString(filteredDbs))
+	common.LogResponseContext(ctx, "GetMysqlUserList", filteredDbs)
 
 	return filteredDbs, nil
 }
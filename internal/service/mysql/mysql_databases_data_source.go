@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -144,7 +143,7 @@ func GetMysqlDatabaseAllList(ctx context.Context, config *conn.ProviderConfig, i
 			PageNo:               ncloud.Int32(pageNo),
 			PageSize:             ncloud.Int32(pageSize),
 		}
-		tflog.Info(ctx, "GetMysqlDatabaseList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "GetMysqlDatabaseList", reqParams)
 
 		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlDatabaseList(reqParams)
 		if err != nil {
@@ -165,7 +164,7 @@ func GetMysqlDatabaseAllList(ctx context.Context, config *conn.ProviderConfig, i
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetMysqlDatabaseList response="+common.MarshalUncheckedString(allDbs))
+	common.LogResponseContext(ctx, "GetMysqlDatabaseList", allDbs)
 
 	return allDbs, nil
 }
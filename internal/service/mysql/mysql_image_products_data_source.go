@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -112,14 +111,14 @@ func (m *mysqlImageProductsDataSource) Read(ctx context.Context, req datasource.
 	reqParams := &vmysql.GetCloudMysqlImageProductListRequest{
 		RegionCode: &m.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetMysqlImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMysqlImageProductList", reqParams)
 
 	mysqlImageProductResp, err := m.config.Client.Vmysql.V2Api.GetCloudMysqlImageProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMysqlImageProductList response="+common.MarshalUncheckedString(mysqlImageProductResp))
+	common.LogResponseContext(ctx, "GetMysqlImageProductList", mysqlImageProductResp)
 
 	if mysqlImageProductResp == nil || len(mysqlImageProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -121,14 +120,14 @@ func (m *mysqlProductsDataSource) Read(ctx context.Context, req datasource.ReadR
 		RegionCode:                 &m.config.RegionCode,
 		CloudMysqlImageProductCode: data.CloudMysqlImageProductCode.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "GetMysqlProductsList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMysqlProductsList", reqParams)
 
 	mysqlProductResp, err := m.config.Client.Vmysql.V2Api.GetCloudMysqlProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetMysqlProductsList response="+common.MarshalUncheckedString(mysqlProductResp))
+	common.LogResponseContext(ctx, "GetMysqlProductsList", mysqlProductResp)
 
 	if mysqlProductResp == nil || len(mysqlProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -16,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -152,7 +151,7 @@ func (r *mysqlRecoveryResource) Create(ctx context.Context, req resource.CreateR
 		reqParams.RecoveryTime = plan.RecoveryTime.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateCloudMysqlRecovery reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateCloudMysqlRecovery", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.CreateCloudMysqlRecoveryInstance(reqParams)
 	if err != nil {
@@ -245,7 +244,7 @@ func (r *mysqlRecoveryResource) Delete(ctx context.Context, req resource.DeleteR
 		RegionCode:                 &r.config.RegionCode,
 		CloudMysqlServerInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMysqlRecovery reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteMysqlRecovery", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlServerInstance(reqParams)
 	if err != nil {
@@ -253,7 +252,7 @@ func (r *mysqlRecoveryResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	tflog.Info(ctx, "DeleteMysqlRecovery response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteMysqlRecovery", response)
 
 	if err := waitMysqlRecoveryDeletion(ctx, r.config, state.MysqlInstanceNo.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -343,13 +342,13 @@ func GetMysqlRecovery(ctx context.Context, config *conn.ProviderConfig, no strin
 		RegionCode:           &config.RegionCode,
 		CloudMysqlInstanceNo: ncloud.String(no),
 	}
-	tflog.Info(ctx, "GetMysqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMysqlDetail", reqParams)
 
 	resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(reqParams)
 	if err != nil && !CheckIfAlreadyDeleted(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMysqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetMysqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMysqlInstanceList) < 1 {
 		return nil, nil
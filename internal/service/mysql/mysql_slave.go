@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -102,7 +101,7 @@ func (r *mysqlSlaveResource) Create(ctx context.Context, req resource.CreateRequ
 		CloudMysqlInstanceNo: plan.MysqlInstanceNo.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "CreateCloudMysqlSlave reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateCloudMysqlSlave", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.CreateCloudMysqlSlaveInstance(reqParams)
 	if err != nil {
@@ -195,7 +194,7 @@ func (r *mysqlSlaveResource) Delete(ctx context.Context, req resource.DeleteRequ
 		RegionCode:                 &r.config.RegionCode,
 		CloudMysqlServerInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteMysqlSlave reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteMysqlSlave", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlServerInstance(reqParams)
 	if err != nil {
@@ -203,7 +202,7 @@ func (r *mysqlSlaveResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	tflog.Info(ctx, "DeleteMysqlSlave response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteMysqlSlave", response)
 
 	if err := waitMysqlSlaveDeletion(ctx, r.config, state.MysqlInstanceNo.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -293,13 +292,13 @@ func GetMysqlSlave(ctx context.Context, config *conn.ProviderConfig, no string)
 		RegionCode:           &config.RegionCode,
 		CloudMysqlInstanceNo: ncloud.String(no),
 	}
-	tflog.Info(ctx, "GetMysqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetMysqlDetail", reqParams)
 
 	resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlInstanceDetail(reqParams)
 	if err != nil && !CheckIfAlreadyDeleted(err) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetMysqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetMysqlDetail", resp)
 
 	if resp == nil || len(resp.CloudMysqlInstanceList) < 1 {
 		return nil, nil
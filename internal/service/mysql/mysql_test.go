@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -49,6 +50,42 @@ func TestAccResourceNcloudMysql_vpc_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudMysql_vpc_writeOnlyPassword(t *testing.T) {
+	var mysqlInstance vmysql.CloudMysqlInstance
+	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
+	resourceName := "ncloud_mysql.mysql"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMysqlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlVpcConfigWriteOnlyPassword(testMysqlName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMysqlExistsWithProvider(resourceName, &mysqlInstance, GetTestProvider(true)),
+					resource.TestCheckNoResourceAttr(resourceName, "user_password"),
+					resource.TestCheckNoResourceAttr(resourceName, "user_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "user_password_wo_version", "1"),
+				),
+			},
+			{
+				// Bumping the version changes the password in place.
+				Config: testAccMysqlVpcConfigWriteOnlyPassword(testMysqlName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[resourceName].Primary.ID; id != ncloud.StringValue(mysqlInstance.CloudMysqlInstanceNo) {
+							return fmt.Errorf("mysql instance replaced: %s", id)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "user_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNcloudMysql_vpc_isHa(t *testing.T) {
 	var mysqlInstance vmysql.CloudMysqlInstance
 	testMysqlName := fmt.Sprintf("tf-mysql-%s", acctest.RandString(5))
@@ -258,6 +295,35 @@ resource "ncloud_mysql" "mysql" {
 `, testMysqlName)
 }
 
+func testAccMysqlVpcConfigWriteOnlyPassword(testMysqlName string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mysql" "mysql" {
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name = "testusername"
+	user_password_wo = "t123456789!a"
+	user_password_wo_version = %[2]d
+	host_ip = "192.168.0.1"
+	database_name = "test_db"
+}
+`, testMysqlName, passwordVersion)
+}
+
 func testAccMysqlVpcConfigIsHa(testMysqlName string, isHa bool, isMultiZone bool, isStorageEncryption bool) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "test_vpc" {
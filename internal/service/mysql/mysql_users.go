@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
@@ -163,14 +162,14 @@ func (r *mysqlUsersResource) Create(ctx context.Context, req resource.CreateRequ
 
 	plan.ID = plan.MysqlInstanceNo
 
-	tflog.Info(ctx, "CreateMysqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateMysqlUserList", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.AddCloudMysqlUserList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateMysqlUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateMysqlUserList", response)
 
 	if response == nil || *response.ReturnCode != "0" {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -242,14 +241,14 @@ func (r *mysqlUsersResource) Update(ctx context.Context, req resource.UpdateRequ
 			CloudMysqlInstanceNo: state.ID.ValueStringPointer(),
 			CloudMysqlUserList:   convertToCloudMysqlUserParameter(plan.MysqlUserList),
 		}
-		tflog.Info(ctx, "ChangecloudMysqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "ChangecloudMysqlUserList", reqParams)
 
 		response, err := r.config.Client.Vmysql.V2Api.ChangeCloudMysqlUserList(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "ChangeCloudMysqlUserList response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "ChangeCloudMysqlUserList", response)
 
 		if response == nil || *response.ReturnCode != "0" {
 			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
@@ -301,14 +300,14 @@ func (r *mysqlUsersResource) Delete(ctx context.Context, req resource.DeleteRequ
 		CloudMysqlInstanceNo: state.MysqlInstanceNo.ValueStringPointer(),
 		CloudMysqlUserList:   convertToCloudMysqlUserKeyParameter(state.MysqlUserList),
 	}
-	tflog.Info(ctx, "DeleteMysqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteMysqlUserList", reqParams)
 
 	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlUserList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteMysqlUserList response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteMysqlUserList", response)
 }
 
 func GetMysqlUserList(ctx context.Context, config *conn.ProviderConfig, id string, users []string) ([]*vmysql.CloudMysqlUser, error) {
@@ -324,7 +323,7 @@ func GetMysqlUserList(ctx context.Context, config *conn.ProviderConfig, id strin
 			PageNo:               ncloud.Int32(pageNo),
 			PageSize:             ncloud.Int32(pageSize),
 		}
-		tflog.Info(ctx, "GetMysqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "GetMysqlUserList", reqParams)
 
 		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlUserList(reqParams)
 		if err != nil {
@@ -359,7 +358,7 @@ func GetMysqlUserList(ctx context.Context, config *conn.ProviderConfig, id strin
 		return nil, nil
 	}
 
-	tflog.Info(ctx, "GetMysqlUserList response="+common.MarshalUncheckedString(filteredUsers))
+	common.LogResponseContext(ctx, "GetMysqlUserList", filteredUsers)
 
 	return filteredUsers, nil
 }
@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -179,7 +178,7 @@ func GetMysqlUserAllList(ctx context.Context, config *conn.ProviderConfig, id st
 			PageNo:               ncloud.Int32(pageNo),
 			PageSize:             ncloud.Int32(pageSize),
 		}
-		tflog.Info(ctx, "GetMysqlUserList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "GetMysqlUserList", reqParams)
 
 		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlUserList(reqParams)
 		if err != nil {
@@ -201,7 +200,7 @@ func GetMysqlUserAllList(ctx context.Context, config *conn.ProviderConfig, id st
 	}
 
 	reverseUsers := common.ReverseList(allUsers)
-	tflog.Info(ctx, "GetMysqlUserList response="+common.MarshalUncheckedString(reverseUsers))
+	common.LogResponseContext(ctx, "GetMysqlUserList", reverseUsers)
 
 	return reverseUsers, nil
 }
@@ -315,7 +315,7 @@ func createNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*stri
 }
 
 func createClassicNasVolume(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
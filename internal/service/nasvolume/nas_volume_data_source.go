@@ -89,7 +89,7 @@ func getNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*N
 func getClassicNasVolumeList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*NasVolume, error) {
 	client := config.Client
 
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
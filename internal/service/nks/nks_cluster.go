@@ -271,15 +271,15 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	LogCommonRequest("resourceNcloudNKSClusterCreate", reqParams)
+	LogCommonRequestContext(ctx, "resourceNcloudNKSClusterCreate", reqParams)
 	resp, err := config.Client.Vnks.V2Api.ClustersPost(ctx, reqParams)
 	if err != nil {
-		LogErrorResponse("resourceNcloudNKSClusterCreate", err, reqParams)
+		LogErrorResponseContext(ctx, "resourceNcloudNKSClusterCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
 	uuid := ncloud.StringValue(resp.Uuid)
 
-	LogResponse("resourceNcloudNKSClusterCreate", resp)
+	LogResponseContext(ctx, "resourceNcloudNKSClusterCreate", resp)
 	if err := waitForNKSClusterActive(ctx, d, config, uuid); err != nil {
 		return diag.FromErr(err)
 	}
@@ -288,11 +288,11 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 	if oidcReq != nil {
 		_, err = config.Client.Vnks.V2Api.ClustersUuidOidcPatch(ctx, oidcReq, resp.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterCreate:oidc", err, oidcReq)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterCreate:oidc", err, oidcReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSClusterCreateoidc:oidc", oidcReq)
+		LogResponseContext(ctx, "resourceNcloudNKSClusterCreateoidc:oidc", oidcReq)
 		if err := waitForNKSClusterActive(ctx, d, config, uuid); err != nil {
 			return diag.FromErr(err)
 		}
@@ -301,7 +301,7 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 	if ipAclReq != nil && !checkFinSite(config) {
 		_, err = config.Client.Vnks.V2Api.ClustersUuidIpAclPatch(ctx, ipAclReq, resp.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterCreate:ipAcl", err, ipAclReq)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterCreate:ipAcl", err, ipAclReq)
 			return diag.FromErr(err)
 		}
 	}
@@ -398,11 +398,11 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 		newVersion := StringPtrOrNil(d.GetOk("k8s_version"))
 		_, err := config.Client.Vnks.V2Api.ClustersUuidUpgradePatch(ctx, cluster.Uuid, newVersion, map[string]interface{}{})
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterUpgrade", err, newVersion)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterUpgrade", err, newVersion)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSClusterUpgrade", newVersion)
+		LogResponseContext(ctx, "resourceNcloudNKSClusterUpgrade", newVersion)
 		if err := waitForNKSClusterActive(ctx, d, config, *cluster.Uuid); err != nil {
 			return diag.FromErr(err)
 		}
@@ -415,11 +415,11 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidOidcPatch(ctx, oidcSpec, cluster.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterOIDCPatch", err, oidcSpec)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterOIDCPatch", err, oidcSpec)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSClusterOIDCPatch", oidcSpec)
+		LogResponseContext(ctx, "resourceNcloudNKSClusterOIDCPatch", oidcSpec)
 		if err := waitForNKSClusterActive(ctx, d, config, *cluster.Uuid); err != nil {
 			return diag.FromErr(err)
 		}
@@ -437,7 +437,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidIpAclPatch(ctx, ipAclReq, cluster.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterIPAclPatch", err, ipAclReq)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterIPAclPatch", err, ipAclReq)
 			return diag.FromErr(err)
 		}
 	}
@@ -453,7 +453,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidLogPatch(ctx, logDto, cluster.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterLogPatch", err, logDto)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterLogPatch", err, logDto)
 			return diag.FromErr(err)
 		}
 
@@ -464,7 +464,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 		lbPrivateSubnetNo, _ := strconv.Atoi(d.Get("lb_private_subnet_no").(string))
 		_, err = config.Client.Vnks.V2Api.ClustersUuidLbSubnetPatch(ctx, cluster.Uuid, ncloud.Int32(int32(lbPrivateSubnetNo)), map[string]interface{}{"igwYn": ncloud.String("N")})
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterLbPrivateSubnetPatch", err, lbPrivateSubnetNo)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterLbPrivateSubnetPatch", err, lbPrivateSubnetNo)
 			return diag.FromErr(err)
 		}
 
@@ -475,7 +475,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 		lbPrivateSubnetNo, _ := strconv.Atoi(d.Get("lb_public_subnet_no").(string))
 		_, err = config.Client.Vnks.V2Api.ClustersUuidLbSubnetPatch(ctx, cluster.Uuid, ncloud.Int32(int32(lbPrivateSubnetNo)), map[string]interface{}{"igwYn": ncloud.String("Y")})
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterLbPublicSubnetPatch", err, lbPrivateSubnetNo)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterLbPublicSubnetPatch", err, lbPrivateSubnetNo)
 			return diag.FromErr(err)
 		}
 
@@ -496,7 +496,7 @@ func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidAddSubnetPatch(ctx, subnets, cluster.Uuid)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSClusterAddSubnetsPatch", err, subnets)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSClusterAddSubnetsPatch", err, subnets)
 			return diag.FromErr(err)
 		}
 
@@ -515,9 +515,9 @@ func resourceNcloudNKSClusterDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("resourceNcloudNKSClusterDelete", d.Id())
+	LogCommonRequestContext(ctx, "resourceNcloudNKSClusterDelete", d.Id())
 	if err := config.Client.Vnks.V2Api.ClustersUuidDelete(ctx, ncloud.String(d.Id())); err != nil {
-		LogErrorResponse("resourceNcloudNKSClusterDelete", err, d.Id())
+		LogErrorResponseContext(ctx, "resourceNcloudNKSClusterDelete", err, d.Id())
 		return diag.FromErr(err)
 	}
 
@@ -71,17 +71,25 @@ func dataSourceNcloudNKSKubeConfigRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func getNKSKubeConfig(ctx context.Context, config *conn.ProviderConfig, uuid string) (kc *KubeConfig, err error) {
-	resp, err := config.Client.Vnks.V2Api.ClustersUuidKubeconfigGet(ctx, ncloud.String(uuid))
+	rawKubeConfig, err := getNKSRawKubeConfig(ctx, config, uuid)
 	if err != nil {
 		return nil, err
 	}
-	err = yaml.Unmarshal([]byte(ncloud.StringValue(resp.Kubeconfig)), &kc)
+	err = yaml.Unmarshal([]byte(rawKubeConfig), &kc)
 	if err != nil {
 		fmt.Printf("%s", err)
 	}
 	return kc, nil
 }
 
+func getNKSRawKubeConfig(ctx context.Context, config *conn.ProviderConfig, uuid string) (string, error) {
+	resp, err := config.Client.Vnks.V2Api.ClustersUuidKubeconfigGet(ctx, ncloud.String(uuid))
+	if err != nil {
+		return "", err
+	}
+	return ncloud.StringValue(resp.Kubeconfig), nil
+}
+
 type KubeConfig struct {
 	Clusters []struct {
 		Cluster struct {
@@ -0,0 +1,133 @@
+package nks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &nksKubeConfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &nksKubeConfigEphemeralResource{}
+)
+
+func NewNKSKubeConfigEphemeralResource() ephemeral.EphemeralResource {
+	return &nksKubeConfigEphemeralResource{}
+}
+
+type nksKubeConfigEphemeralResource struct {
+	config *conn.ProviderConfig
+}
+
+func (e *nksKubeConfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nks_kube_config"
+}
+
+func (e *nksKubeConfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.config = config
+}
+
+func (e *nksKubeConfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kubeconfig of a NKS cluster, which is never persisted in the plan or state",
+		Attributes: map[string]schema.Attribute{
+			"cluster_uuid": schema.StringAttribute{
+				Required: true,
+			},
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kube_config": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Kubeconfig YAML of the cluster",
+			},
+		},
+	}
+}
+
+func (e *nksKubeConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data nksKubeConfigEphemeralResourceModel
+
+	if !e.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"NOT SUPPORT CLASSIC",
+			"ephemeral resource does not support CLASSIC. only VPC.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rawKubeConfig, err := getNKSRawKubeConfig(ctx, e.config, data.ClusterUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	var kubeConfig KubeConfig
+	if err := yaml.Unmarshal([]byte(rawKubeConfig), &kubeConfig); err != nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("parsing kubeconfig: %s", err))
+		return
+	}
+
+	if len(kubeConfig.Clusters) == 0 {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("no cluster in kubeconfig of %s", data.ClusterUuid.ValueString()))
+		return
+	}
+
+	data.Host = types.StringValue(kubeConfig.Clusters[0].Cluster.Server)
+	data.ClusterCaCertificate = types.StringValue(kubeConfig.Clusters[0].Cluster.ClusterCaCertificate)
+	data.ClientCertificate = types.StringNull()
+	data.ClientKey = types.StringNull()
+	if len(kubeConfig.Users) > 0 {
+		data.ClientCertificate = types.StringValue(kubeConfig.Users[0].User.ClientCertificateData)
+		data.ClientKey = types.StringValue(kubeConfig.Users[0].User.ClientKeyData)
+	}
+	data.KubeConfig = types.StringValue(rawKubeConfig)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+type nksKubeConfigEphemeralResourceModel struct {
+	ClusterUuid          types.String `tfsdk:"cluster_uuid"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCaCertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	KubeConfig           types.String `tfsdk:"kube_config"`
+}
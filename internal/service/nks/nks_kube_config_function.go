@@ -0,0 +1,84 @@
+package nks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+var _ function.Function = &kubeConfigFunction{}
+
+func NewKubeConfigFunction() function.Function {
+	return &kubeConfigFunction{}
+}
+
+type kubeConfigFunction struct{}
+
+func (f *kubeConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kubeconfig"
+}
+
+func (f *kubeConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decodes a NKS kubeconfig",
+		Description: "Decodes the kubeconfig YAML of a NKS cluster into the host, cluster CA certificate and client credentials of its first cluster and user.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kubeconfig",
+				Description: "Kubeconfig YAML",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"host":                   types.StringType,
+				"cluster_ca_certificate": types.StringType,
+				"client_certificate":     types.StringType,
+				"client_key":             types.StringType,
+			},
+		},
+	}
+}
+
+func (f *kubeConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rawKubeConfig string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rawKubeConfig))
+	if resp.Error != nil {
+		return
+	}
+
+	var kubeConfig KubeConfig
+	if err := yaml.Unmarshal([]byte(rawKubeConfig), &kubeConfig); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("parsing kubeconfig: %s", err))
+		return
+	}
+
+	if len(kubeConfig.Clusters) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "no cluster in kubeconfig")
+		return
+	}
+
+	result := kubeConfigFunctionModel{
+		Host:                 types.StringValue(kubeConfig.Clusters[0].Cluster.Server),
+		ClusterCaCertificate: types.StringValue(kubeConfig.Clusters[0].Cluster.ClusterCaCertificate),
+		ClientCertificate:    types.StringNull(),
+		ClientKey:            types.StringNull(),
+	}
+	if len(kubeConfig.Users) > 0 {
+		result.ClientCertificate = types.StringValue(kubeConfig.Users[0].User.ClientCertificateData)
+		result.ClientKey = types.StringValue(kubeConfig.Users[0].User.ClientKeyData)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type kubeConfigFunctionModel struct {
+	Host                 types.String `tfsdk:"host"`
+	ClusterCaCertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+}
@@ -269,14 +269,14 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 		reqParams.Autoscale = expandNKSNodePoolAutoScale(d.Get("autoscale").([]interface{}))
 	}
 
-	LogCommonRequest("resourceNcloudNKSNodePoolCreate", reqParams)
+	LogCommonRequestContext(ctx, "resourceNcloudNKSNodePoolCreate", reqParams)
 	_, err := config.Client.Vnks.V2Api.ClustersUuidNodePoolPost(ctx, reqParams, ncloud.String(clusterUuid))
 	if err != nil {
-		LogErrorResponse("resourceNcloudNKSNodePoolCreate", err, reqParams)
+		LogErrorResponseContext(ctx, "resourceNcloudNKSNodePoolCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
 
-	LogResponse("resourceNcloudNKSNodePoolCreate", reqParams)
+	LogResponseContext(ctx, "resourceNcloudNKSNodePoolCreate", reqParams)
 	if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, ncloud.StringValue(reqParams.Name)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -301,11 +301,11 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoTaintsPut(ctx, nodePoolTaintReq, &clusterUuid, &instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolCreate - put taints", err, nodePoolTaintReq)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSNodePoolCreate - put taints", err, nodePoolTaintReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolCreate - put taints", reqParams)
+		LogResponseContext(ctx, "resourceNcloudNKSNodePoolCreate - put taints", reqParams)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, ncloud.StringValue(reqParams.Name)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -329,11 +329,11 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoLabelsPut(ctx, labelsReq, &clusterUuid, &instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolCreate - put labels", err, labelsReq)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSNodePoolCreate - put labels", err, labelsReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolCreate - put labels", reqParams)
+		LogResponseContext(ctx, "resourceNcloudNKSNodePoolCreate - put labels", reqParams)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, ncloud.StringValue(reqParams.Name)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -422,11 +422,11 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChanges("k8s_version") {
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoUpgradePatch(ctx, ncloud.String(clusterUuid), instanceNo, k8sVersion, map[string]interface{}{})
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodepoolUpgrade", err, k8sVersion)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSNodepoolUpgrade", err, k8sVersion)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodepoolUpgrade", k8sVersion)
+		LogResponseContext(ctx, "resourceNcloudNKSNodepoolUpgrade", k8sVersion)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
 			return diag.FromErr(err)
 		}
@@ -443,11 +443,11 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 		err := config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoPatch(ctx, reqParams, ncloud.String(clusterUuid), instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolUpdate", err, reqParams)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSNodePoolUpdate", err, reqParams)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolUpdate", reqParams)
+		LogResponseContext(ctx, "resourceNcloudNKSNodePoolUpdate", reqParams)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
 			return diag.FromErr(err)
 		}
@@ -460,11 +460,11 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoTaintsPut(ctx, nodePoolTaintReq, &clusterUuid, instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolUpdate - put taints", err, nodePoolTaintReq)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSNodePoolUpdate - put taints", err, nodePoolTaintReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolUpdate - put taints", nodePoolTaintReq)
+		LogResponseContext(ctx, "resourceNcloudNKSNodePoolUpdate - put taints", nodePoolTaintReq)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
 			return diag.FromErr(err)
 		}
@@ -481,11 +481,11 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoLabelsPut(ctx, labelsReq, &clusterUuid, instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolUpdate - put labels", err, labelsReq)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSNodePoolUpdate - put labels", err, labelsReq)
 			return diag.FromErr(err)
 		}
 
-		LogResponse("resourceNcloudNKSNodePoolUpdate - put labels", labelsReq)
+		LogResponseContext(ctx, "resourceNcloudNKSNodePoolUpdate - put labels", labelsReq)
 		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
 			return diag.FromErr(err)
 		}
@@ -502,7 +502,7 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 		_, err = config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoSubnetsPatch(ctx, subnetReq, &clusterUuid, instanceNo)
 		if err != nil {
-			LogErrorResponse("resourceNcloudNKSNodePoolUpdate - addSubnets", err, subnetReq)
+			LogErrorResponseContext(ctx, "resourceNcloudNKSNodePoolUpdate - addSubnets", err, subnetReq)
 			return diag.FromErr(err)
 		}
 
@@ -527,9 +527,9 @@ func resourceNcloudNKSNodePoolDelete(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("resourceNcloudNKSNodePoolDelete", d.Id())
+	LogCommonRequestContext(ctx, "resourceNcloudNKSNodePoolDelete", d.Id())
 	if err := config.Client.Vnks.V2Api.ClustersUuidNodePoolInstanceNoDelete(ctx, ncloud.String(clusterUuid), instanceNo); err != nil {
-		LogErrorResponse("resourceNcloudNKSNodePoolDelete", err, instanceNo)
+		LogErrorResponseContext(ctx, "resourceNcloudNKSNodePoolDelete", err, instanceNo)
 		return diag.FromErr(err)
 	}
 
@@ -616,7 +616,7 @@ func getNKSNodePools(ctx context.Context, config *conn.ProviderConfig, uuid stri
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("getNKSNodePools", resp)
+	LogResponseContext(ctx, "getNKSNodePools", resp)
 
 	return resp.NodePool, nil
 }
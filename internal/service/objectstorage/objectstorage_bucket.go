@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -55,7 +54,7 @@ func (o *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		Bucket: plan.BucketName.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "CreateObjectStorage reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateObjectStorage", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.CreateBucket(ctx, reqParams)
 	if err != nil {
@@ -67,7 +66,7 @@ func (o *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	tflog.Info(ctx, "CreateObjectStorage response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateObjectStorage", response)
 
 	err = waitBucketCreated(ctx, o.config, plan.BucketName.String())
 	if err != nil {
@@ -91,7 +90,7 @@ func (o *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Bucket: plan.BucketName.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteBucket reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteBucket", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.DeleteBucket(ctx, reqParams)
 	if err != nil {
@@ -99,7 +98,7 @@ func (o *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	tflog.Info(ctx, "DeleteBucket response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteBucket", response)
 
 	if err := waitBucketDeleted(ctx, o.config, plan.BucketName.String()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -126,7 +125,7 @@ func (b *bucketACLResource) Create(ctx context.Context, req resource.CreateReque
 		ACL:    plan.Rule,
 	}
 
-	tflog.Info(ctx, "PutBucketACL reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "PutBucketACL", reqParams)
 
 	response, err := b.config.Client.ObjectStorage.PutBucketAcl(ctx, reqParams)
 	if err != nil {
@@ -134,7 +133,7 @@ func (b *bucketACLResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	tflog.Info(ctx, "PutBucketACL response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "PutBucketACL", response)
 
 	if err := waitBucketACLApplied(ctx, b.config, bucketName); err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
@@ -184,7 +183,7 @@ func (b *bucketACLResource) Update(ctx context.Context, req resource.UpdateReque
 			ACL:    plan.Rule,
 		}
 
-		tflog.Info(ctx, "PutBucketACL update operation reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "PutBucketACL update operation", reqParams)
 
 		response, err := b.config.Client.ObjectStorage.PutBucketAcl(ctx, reqParams)
 		if err != nil {
@@ -192,7 +191,7 @@ func (b *bucketACLResource) Update(ctx context.Context, req resource.UpdateReque
 			return
 		}
 
-		tflog.Info(ctx, "PutBucketACL update operation response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "PutBucketACL update operation", response)
 
 		if err := waitBucketACLApplied(ctx, b.config, bucketName); err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
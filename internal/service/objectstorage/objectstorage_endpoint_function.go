@@ -0,0 +1,55 @@
+package objectstorage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var _ function.Function = &endpointFunction{}
+
+func NewEndpointFunction() function.Function {
+	return &endpointFunction{}
+}
+
+type endpointFunction struct{}
+
+func (f *endpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "objectstorage_endpoint"
+}
+
+func (f *endpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the Object Storage endpoint of a region and site",
+		Description: "Returns the S3 compatible Object Storage endpoint which the provider uses for the region code and site (public / gov / fin).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "region",
+				Description: "Region code",
+			},
+			function.StringParameter{
+				Name:        "site",
+				Description: "Site of ncloud (public / gov / fin)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *endpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region, site string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region, &site))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(region) < 2 {
+		resp.Error = function.NewArgumentFuncError(0, "region must be a region code such as `KR`")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, conn.GenEndpointWithCode(region, site)))
+}
@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -73,7 +72,7 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "PutObject reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "PutObject", reqParams)
 
 	output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
 	if err != nil {
@@ -85,7 +84,7 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	tflog.Info(ctx, "PutObject response="+common.MarshalUncheckedString(output))
+	common.LogResponseContext(ctx, "PutObject", output)
 
 	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
@@ -109,7 +108,7 @@ func (o *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Key:    plan.Key.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteObject reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteObject", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.DeleteObject(ctx, reqParams)
 	if err != nil {
@@ -117,7 +116,7 @@ func (o *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	tflog.Info(ctx, "DeleteObject response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteObject", response)
 
 	if err := waitObjectDeleted(ctx, o.config, plan.Bucket.String(), plan.Key.String()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -245,7 +244,7 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 			Key:    state.Key.ValueStringPointer(),
 		}
 
-		tflog.Info(ctx, "GetObject at update operation reqParams="+common.MarshalUncheckedString(getReqParams))
+		common.LogCommonRequestContext(ctx, "GetObject at update operation", getReqParams)
 
 		getOutput, err := o.config.Client.ObjectStorage.GetObject(ctx, getReqParams)
 		if err != nil {
@@ -257,7 +256,7 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
-		tflog.Info(ctx, "GetObject at update operation response="+common.MarshalUncheckedString(getOutput))
+		common.LogResponseContext(ctx, "GetObject at update operation", getOutput)
 
 		reqParams.Body = getOutput.Body
 	}
@@ -279,7 +278,7 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		reqParams.ContentType = plan.ContentType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "PutObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "PutObject at update operation", reqParams)
 
 	output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
 	if err != nil {
@@ -291,7 +290,7 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	tflog.Info(ctx, "PutObject at update operation response="+common.MarshalUncheckedString(output))
+	common.LogResponseContext(ctx, "PutObject at update operation", output)
 
 	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -58,7 +57,7 @@ func (o *objectACLResource) Create(ctx context.Context, req resource.CreateReque
 		ACL:    plan.Rule,
 	}
 
-	tflog.Info(ctx, "PutObjectACL reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "PutObjectACL", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.PutObjectAcl(ctx, reqParams)
 	if err != nil {
@@ -66,7 +65,7 @@ func (o *objectACLResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	tflog.Info(ctx, "PutObjectACL response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "PutObjectACL", response)
 
 	if err := waitObjectACLApplied(ctx, o.config, bucketName, key); err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
@@ -190,7 +189,7 @@ func (o *objectACLResource) Update(ctx context.Context, req resource.UpdateReque
 			ACL:    plan.Rule,
 		}
 
-		tflog.Info(ctx, "PutObjectACL update operation reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "PutObjectACL update operation", reqParams)
 
 		response, err := o.config.Client.ObjectStorage.PutObjectAcl(ctx, reqParams)
 		if err != nil {
@@ -198,7 +197,7 @@ func (o *objectACLResource) Update(ctx context.Context, req resource.UpdateReque
 			return
 		}
 
-		tflog.Info(ctx, "PutObjectACL update operation response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "PutObjectACL update operation", response)
 
 		if err := waitObjectACLApplied(ctx, o.config, bucketName, key); err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -82,7 +81,7 @@ func (o *objectCopyResource) Create(ctx context.Context, req resource.CreateRequ
 		reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CopyObject reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CopyObject", reqParams)
 
 	output, err := o.config.Client.ObjectStorage.CopyObject(ctx, reqParams)
 	if err != nil {
@@ -94,7 +93,7 @@ func (o *objectCopyResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	tflog.Info(ctx, "CopyObject response="+common.MarshalUncheckedString(output))
+	common.LogResponseContext(ctx, "CopyObject", output)
 
 	if err := waitObjectCopied(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("COPYING ERROR", err.Error())
@@ -118,7 +117,7 @@ func (o *objectCopyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		Key:    plan.Key.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteObject reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteObject", reqParams)
 
 	response, err := o.config.Client.ObjectStorage.DeleteObject(ctx, reqParams)
 	if err != nil {
@@ -126,7 +125,7 @@ func (o *objectCopyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	tflog.Info(ctx, "DeleteObject response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteObject", response)
 
 	if err := waitObjectCopyDeleted(ctx, o.config, plan.Bucket.String(), plan.Key.String()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -252,7 +251,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
 		}
 
-		tflog.Info(ctx, "CopyObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "CopyObject at update operation", reqParams)
 
 		output, err := o.config.Client.ObjectStorage.CopyObject(ctx, reqParams)
 		if err != nil {
@@ -264,7 +263,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		tflog.Info(ctx, "CopyObject at update operation response="+common.MarshalUncheckedString(output))
+		common.LogResponseContext(ctx, "CopyObject at update operation", output)
 
 		if err := waitObjectCopied(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
@@ -286,7 +285,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			Key:    state.Key.ValueStringPointer(),
 		}
 
-		tflog.Info(ctx, "GetObject at update operation reqParams="+common.MarshalUncheckedString(getReqParams))
+		common.LogCommonRequestContext(ctx, "GetObject at update operation", getReqParams)
 
 		getOutput, err := o.config.Client.ObjectStorage.GetObject(ctx, getReqParams)
 		if err != nil {
@@ -298,7 +297,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		tflog.Info(ctx, "GetObject at update operation response="+common.MarshalUncheckedString(getOutput))
+		common.LogResponseContext(ctx, "GetObject at update operation", getOutput)
 
 		reqParams := &s3.PutObjectInput{
 			Bucket: plan.Bucket.ValueStringPointer(),
@@ -308,7 +307,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			ContentType: plan.ContentType.ValueStringPointer(),
 		}
 
-		tflog.Info(ctx, "PutObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "PutObject at update operation", reqParams)
 
 		output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
 		if err != nil {
@@ -320,7 +319,7 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		tflog.Info(ctx, "PutObject at update operation response="+common.MarshalUncheckedString(output))
+		common.LogResponseContext(ctx, "PutObject at update operation", output)
 
 		if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
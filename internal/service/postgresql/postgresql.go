@@ -9,7 +9,6 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -86,6 +85,15 @@ func (r *postgresqlResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 func (r *postgresqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+		verifystring.NotContain(path.MatchRoot("user_name").String()),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"service_name": schema.StringAttribute{
@@ -170,22 +178,23 @@ func (r *postgresqlResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"user_password": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-						verifystring.NotContain(path.MatchRoot("user_name").String()),
-					),
+					userPasswordValidator,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_password_wo")),
 				},
 				Sensitive: true,
 			},
+			"user_password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					userPasswordValidator,
+				},
+				Description: "Write-only access password for user, which is never stored in the state. Use instead of `user_password`.",
+			},
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo"),
 			"image_product_code": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -424,6 +433,12 @@ func (r *postgresqlResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	userPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.UserPassword, path.Root("user_password_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnet, err := vpc.GetSubnetInstance(r.config, plan.SubnetNo.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -437,7 +452,7 @@ func (r *postgresqlResource) Create(ctx context.Context, req resource.CreateRequ
 		CloudPostgresqlServiceName:      plan.ServiceName.ValueStringPointer(),
 		CloudPostgresqlServerNamePrefix: plan.ServerNamePrefix.ValueStringPointer(),
 		CloudPostgresqlUserName:         plan.UserName.ValueStringPointer(),
-		CloudPostgresqlUserPassword:     plan.UserPassword.ValueStringPointer(),
+		CloudPostgresqlUserPassword:     userPassword.ValueStringPointer(),
 		CloudPostgresqlDatabaseName:     plan.DatabaseName.ValueStringPointer(),
 		ClientCidr:                      plan.ClientCidr.ValueStringPointer(),
 		VpcNo:                           subnet.VpcNo,
@@ -567,14 +582,14 @@ func (r *postgresqlResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 	}
 
-	tflog.Info(ctx, "CreatePostgresql reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreatePostgresql", reqParams)
 
 	response, err := r.config.Client.Vpostgresql.V2Api.CreateCloudPostgresqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreatePostgresql response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreatePostgresql", response)
 
 	if response == nil || len(response.CloudPostgresqlInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -633,7 +648,71 @@ func (r *postgresqlResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
-func (r *postgresqlResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+// Update only changes the user password, as every other change requires replacement.
+func (r *postgresqlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state postgresqlResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.UserPassword.Equal(state.UserPassword) || !plan.UserPasswordWoVersion.Equal(state.UserPasswordWoVersion) {
+		userPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.UserPassword, path.Root("user_password_wo"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		user, err := GetPostgresqlUser(ctx, r.config, state.ID.ValueString(), state.UserName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("READING ERROR", err.Error())
+			return
+		}
+
+		if user == nil {
+			resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("user %s not found", state.UserName.ValueString()))
+			return
+		}
+
+		reqParams := &vpostgresql.ChangeCloudPostgresqlUserListRequest{
+			RegionCode:                &r.config.RegionCode,
+			CloudPostgresqlInstanceNo: state.ID.ValueStringPointer(),
+			CloudPostgresqlUserList: []*vpostgresql.CloudPostgresqlUserParameter{
+				{
+					Name:              user.UserName,
+					ClientCidr:        user.ClientCidr,
+					Password:          userPassword.ValueStringPointer(),
+					IsReplicationRole: user.IsReplicationRole,
+				},
+			},
+		}
+		common.LogCommonRequestContext(ctx, "ChangeCloudPostgresqlUserList", reqParams)
+
+		response, err := r.config.Client.Vpostgresql.V2Api.ChangeCloudPostgresqlUserList(reqParams)
+		if err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+		common.LogResponseContext(ctx, "ChangeCloudPostgresqlUserList", response)
+
+		if response == nil || *response.ReturnCode != "0" {
+			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
+			return
+		}
+
+		if _, err := WaitPostgresqlCreation(ctx, r.config, state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("WAITING FOR UPDATE ERROR", err.Error())
+			return
+		}
+	}
+
+	state.UserPassword = plan.UserPassword
+	state.UserPasswordWoVersion = plan.UserPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *postgresqlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -648,14 +727,14 @@ func (r *postgresqlResource) Delete(ctx context.Context, req resource.DeleteRequ
 		RegionCode:                &r.config.RegionCode,
 		CloudPostgresqlInstanceNo: state.ID.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeletePostgresql reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeletePostgresql", reqParams)
 
 	response, err := r.config.Client.Vpostgresql.V2Api.DeleteCloudPostgresqlInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeletePostgresql response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeletePostgresql", response)
 
 	if err := waitPostgresqlDeletion(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -667,13 +746,13 @@ func GetPostgresqlInstance(ctx context.Context, config *conn.ProviderConfig, no
 		RegionCode:                &config.RegionCode,
 		CloudPostgresqlInstanceNo: ncloud.String(no),
 	}
-	tflog.Info(ctx, "GetPostgresqlDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetPostgresqlDetail", reqParams)
 
 	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlInstanceDetail(reqParams)
 	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetPostgresqlDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetPostgresqlDetail", resp)
 
 	if resp == nil || len(resp.CloudPostgresqlInstanceList) < 1 || len(resp.CloudPostgresqlInstanceList[0].CloudPostgresqlServerInstanceList) < 1 {
 		return nil, nil
@@ -682,6 +761,32 @@ func GetPostgresqlInstance(ctx context.Context, config *conn.ProviderConfig, no
 	return resp.CloudPostgresqlInstanceList[0], nil
 }
 
+func GetPostgresqlUser(ctx context.Context, config *conn.ProviderConfig, id string, userName string) (*vpostgresql.CloudPostgresqlUser, error) {
+	reqParams := &vpostgresql.GetCloudPostgresqlUserListRequest{
+		RegionCode:                &config.RegionCode,
+		CloudPostgresqlInstanceNo: ncloud.String(id),
+	}
+	common.LogCommonRequestContext(ctx, "GetPostgresqlUserList", reqParams)
+
+	resp, err := config.Client.Vpostgresql.V2Api.GetCloudPostgresqlUserList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	common.LogResponseContext(ctx, "GetPostgresqlUserList", resp)
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	for _, user := range resp.CloudPostgresqlUserList {
+		if user != nil && ncloud.StringValue(user.UserName) == userName {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func WaitPostgresqlCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vpostgresql.CloudPostgresqlInstance, error) {
 	var postgresqlInstance *vpostgresql.CloudPostgresqlInstance
 	stateConf := &retry.StateChangeConf{
@@ -773,6 +878,8 @@ type postgresqlResourceModel struct {
 	SecondarySubnetNo         types.String `tfsdk:"secondary_subnet_no"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	IsMultiZone               types.Bool   `tfsdk:"is_multi_zone"`
 	IsHa                      types.Bool   `tfsdk:"is_ha"`
 	IsStorageEncryption       types.Bool   `tfsdk:"is_storage_encryption"`
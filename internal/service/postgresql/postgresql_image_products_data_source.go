@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -114,14 +113,14 @@ func (d *postgresqlImageProductsDataSource) Read(ctx context.Context, req dataso
 	reqParams := &vpostgresql.GetCloudPostgresqlImageProductListRequest{
 		RegionCode: &d.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetPostgresqlImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetPostgresqlImageProductList", reqParams)
 
 	postgresqlImageProductResp, err := d.config.Client.Vpostgresql.V2Api.GetCloudPostgresqlImageProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetPostgresqlImageProductList response="+common.MarshalUncheckedString(postgresqlImageProductResp))
+	common.LogResponseContext(ctx, "GetPostgresqlImageProductList", postgresqlImageProductResp)
 
 	if postgresqlImageProductResp == nil || len(postgresqlImageProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
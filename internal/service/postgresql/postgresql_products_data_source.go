@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -122,14 +121,14 @@ func (d *postgresqlProductsDataSource) Read(ctx context.Context, req datasource.
 		RegionCode:                      &d.config.RegionCode,
 		CloudPostgresqlImageProductCode: data.CloudPostgresqlImageProductCode.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "GetPostgresqlProductsList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetPostgresqlProductsList", reqParams)
 
 	postgresqlProductResp, err := d.config.Client.Vpostgresql.V2Api.GetCloudPostgresqlProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetPostgresqlProductsList response="+common.MarshalUncheckedString(postgresqlProductResp))
+	common.LogResponseContext(ctx, "GetPostgresqlProductsList", postgresqlProductResp)
 
 	if postgresqlProductResp == nil || len(postgresqlProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -36,6 +35,7 @@ var (
 	_ resource.Resource                = &redisResource{}
 	_ resource.ResourceWithConfigure   = &redisResource{}
 	_ resource.ResourceWithImportState = &redisResource{}
+	_ resource.ResourceWithModifyPlan  = &redisResource{}
 )
 
 func NewRedisResource() resource.Resource {
@@ -51,6 +51,14 @@ func (r *redisResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (r *redisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	userPasswordValidator := stringvalidator.All(
+		stringvalidator.LengthBetween(8, 20),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+	)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"service_name": schema.StringAttribute{
@@ -94,20 +102,26 @@ func (r *redisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"user_password": schema.StringAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					framework.RequiresReplaceUnlessWriteOnly("user_password_wo"),
 				},
 				Validators: []validator.String{
-					stringvalidator.All(
-						stringvalidator.LengthBetween(8, 20),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
-						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
-					),
+					userPasswordValidator,
+					stringvalidator.ConflictsWith(path.MatchRoot("user_password_wo")),
 				},
 				Sensitive: true,
 			},
-			"id": framework.IDAttribute(),
+			// Available only `gov` site
+			"user_password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Validators: []validator.String{
+					userPasswordValidator,
+				},
+				Description: "Write-only access password for user, which is never stored in the state. Use instead of `user_password`.",
+			},
+			"user_password_wo_version": framework.WriteOnlyVersionAttribute("user_password_wo"),
+			"id":                       framework.IDAttribute(),
 			"vpc_no": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -323,6 +337,12 @@ func (r *redisResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	userPassword, diags := framework.StringValueOrWriteOnly(ctx, req.Config, plan.UserPassword, path.Root("user_password_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	reqParams := &vredis.CreateCloudRedisInstanceRequest{
 		RegionCode:                 &r.config.RegionCode,
 		CloudRedisServiceName:      plan.ServiceName.ValueStringPointer(),
@@ -452,18 +472,18 @@ func (r *redisResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Available only `gov` site
-	if !plan.UserPassword.IsNull() {
-		reqParams.CloudRedisUserPassword = plan.UserPassword.ValueStringPointer()
+	if !userPassword.IsNull() {
+		reqParams.CloudRedisUserPassword = userPassword.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateCloudRedisInstance reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateCloudRedisInstance", reqParams)
 
 	response, err := r.config.Client.Vredis.V2Api.CreateCloudRedisInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateCloudRedisInstance response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateCloudRedisInstance", response)
 
 	if response == nil || len(response.CloudRedisInstanceList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -511,7 +531,26 @@ func (r *redisResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 }
 
-func (r *redisResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+func (r *redisResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The API has no way to change the user password of an existing instance.
+	framework.DenyWriteOnlyVersionChange(ctx, req, resp, path.Root("user_password"), path.Root("user_password_wo_version"))
+}
+
+// Update only records moving the user password to `user_password_wo`, as every other change requires replacement.
+func (r *redisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state redisResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.UserPassword = plan.UserPassword
+	state.UserPasswordWoVersion = plan.UserPasswordWoVersion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *redisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -527,7 +566,7 @@ func (r *redisResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		CloudRedisInstanceNo: state.ID.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteCloudRedis reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteCloudRedis", reqParams)
 
 	response, err := r.config.Client.Vredis.V2Api.DeleteCloudRedisInstance(reqParams)
 	if err != nil {
@@ -535,7 +574,7 @@ func (r *redisResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	tflog.Info(ctx, "DeleteCloudRedis response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteCloudRedis", response)
 
 	if err := waitRedisDeleted(ctx, r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -551,14 +590,14 @@ func GetRedisDetail(ctx context.Context, config *conn.ProviderConfig, no string)
 		RegionCode:           &config.RegionCode,
 		CloudRedisInstanceNo: &no,
 	}
-	tflog.Info(ctx, "GetRedisDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetRedisDetail", reqParams)
 
 	resp, err := config.Client.Vredis.V2Api.GetCloudRedisInstanceDetail(reqParams)
 	// If the lookup result is 0 or already deleted, it will respond with a 400 error with a 5001017 return code.
 	if err != nil && !(strings.Contains(err.Error(), `"returnCode": "5001017"`)) {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetRedisDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetRedisDetail", resp)
 
 	if resp == nil || len(resp.CloudRedisInstanceList) < 1 {
 		return nil, nil
@@ -638,6 +677,8 @@ type redisResourceModel struct {
 	ServerNamePrefix          types.String `tfsdk:"server_name_prefix"`
 	UserName                  types.String `tfsdk:"user_name"`
 	UserPassword              types.String `tfsdk:"user_password"`
+	UserPasswordWo            types.String `tfsdk:"user_password_wo"`
+	UserPasswordWoVersion     types.Int64  `tfsdk:"user_password_wo_version"`
 	ID                        types.String `tfsdk:"id"`
 	VpcNo                     types.String `tfsdk:"vpc_no"`
 	SubnetNo                  types.String `tfsdk:"subnet_no"`
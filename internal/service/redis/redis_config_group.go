@@ -8,7 +8,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
@@ -116,14 +115,14 @@ func (r *redisConfigGroupResource) Create(ctx context.Context, req resource.Crea
 		ConfigGroupDescription: plan.Description.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "CreateCloudRedisConfigGroup reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "CreateCloudRedisConfigGroup", reqParams)
 
 	response, err := r.config.Client.Vredis.V2Api.CreateCloudRedisConfigGroup(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateCloudRedisConfigGroup response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateCloudRedisConfigGroup", response)
 
 	if response == nil || len(response.CloudRedisConfigGroupList) < 1 {
 		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
@@ -201,7 +200,7 @@ func (r *redisConfigGroupResource) Delete(ctx context.Context, req resource.Dele
 		ConfigGroupNo: state.ID.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteCloudRedisConfigGroup reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteCloudRedisConfigGroup", reqParams)
 
 	response, err := r.config.Client.Vredis.V2Api.DeleteCloudRedisConfigGroup(reqParams)
 	if err != nil {
@@ -209,7 +208,7 @@ func (r *redisConfigGroupResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	tflog.Info(ctx, "DeleteCloudRedisConfigGroup response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteCloudRedisConfigGroup", response)
 
 	if err := waitRedisConfigGroupDeleted(ctx, r.config, state.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -226,13 +225,13 @@ func GetRedisConfigGroup(ctx context.Context, config *conn.ProviderConfig, name
 		ConfigGroupName: &name,
 	}
 
-	tflog.Info(ctx, "GetRedisConfigGroup reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetRedisConfigGroup", reqParams)
 
 	resp, err := config.Client.Vredis.V2Api.GetCloudRedisConfigGroupList(reqParams)
 	if err != nil {
 		return nil, err
 	}
-	tflog.Info(ctx, "GetRedisConfigGroup response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetRedisConfigGroup", resp)
 
 	if resp == nil || len(resp.CloudRedisConfigGroupList) < 1 {
 		return nil, nil
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
 
@@ -182,14 +181,14 @@ func (r *redisDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			RegionCode:            &r.config.RegionCode,
 			CloudRedisServiceName: data.ServiceName.ValueStringPointer(),
 		}
-		tflog.Info(ctx, "GetRedisList reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "GetRedisList", reqParams)
 
 		listResp, err := r.config.Client.Vredis.V2Api.GetCloudRedisInstanceList(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("READING ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "GetRedisList response="+common.MarshalUncheckedString(listResp))
+		common.LogResponseContext(ctx, "GetRedisList", listResp)
 
 		if listResp == nil || len(listResp.CloudRedisInstanceList) < 1 {
 			resp.Diagnostics.AddError("READING ERROR", "no result. please change search criteria and try again.")
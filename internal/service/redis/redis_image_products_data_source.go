@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -112,14 +111,14 @@ func (r *redisImageProductsDataSource) Read(ctx context.Context, req datasource.
 	reqParams := &vredis.GetCloudRedisImageProductListRequest{
 		RegionCode: &r.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetRedisImageProductList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetRedisImageProductList", reqParams)
 
 	redisImageProductResp, err := r.config.Client.Vredis.V2Api.GetCloudRedisImageProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetRedisImageProductList response="+common.MarshalUncheckedString(redisImageProductResp))
+	common.LogResponseContext(ctx, "GetRedisImageProductList", redisImageProductResp)
 
 	if redisImageProductResp == nil || len(redisImageProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -121,14 +120,14 @@ func (r *redisProductsDataSource) Read(ctx context.Context, req datasource.ReadR
 		RegionCode:                 &r.config.RegionCode,
 		CloudRedisImageProductCode: data.CloudRedisImageProductCode.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "GetRedisProductList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetRedisProductList", reqParams)
 
 	redisProductResp, err := r.config.Client.Vredis.V2Api.GetCloudRedisProductList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetRedisProductList response="+common.MarshalUncheckedString(redisProductResp))
+	common.LogResponseContext(ctx, "GetRedisProductList", redisProductResp)
 
 	if redisProductResp == nil || len(redisProductResp.ProductList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+func ResourceNcloudAccessControlGroupIngressRule() *schema.Resource {
+	return resourceNcloudAccessControlGroupSingleRule("inbound")
+}
+
+// resourceNcloudAccessControlGroupSingleRule manages exactly one rule of ruleType ("inbound" or "outbound").
+// ID format: {access_control_group_no}:{protocol}:{port_range}:{ip_block or source_access_control_group_no}
+func resourceNcloudAccessControlGroupSingleRule(ruleType string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceNcloudAccessControlGroupSingleRuleCreate(d, meta, ruleType)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceNcloudAccessControlGroupSingleRuleRead(d, meta, ruleType)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceNcloudAccessControlGroupSingleRuleDelete(d, meta, ruleType)
+		},
+		Importer: &schema.ResourceImporter{
+			State: resourceNcloudAccessControlGroupSingleRuleImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"access_control_group_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringMatch(regexp.MustCompile(`TCP|UDP|ICMP|\b([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-4])\b`), "only TCP, UDP, ICMP and 1-254 are valid values."),
+					validation.StringNotInSlice([]string{"1", "6", "17"}, false),
+				)),
+			},
+			"port_range": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
+				Default:          "",
+			},
+			"ip_block": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
+				ExactlyOneOf:     []string{"ip_block", "source_access_control_group_no"},
+			},
+			"source_access_control_group_no": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ip_block", "source_access_control_group_no"},
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+				Default:          "",
+			},
+		},
+	}
+}
+
+func resourceNcloudAccessControlGroupSingleRuleCreate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if !config.SupportVPC {
+		return NotSupportClassic(fmt.Sprintf("resource `ncloud_access_control_group_%s_rule`", accessControlGroupSingleRuleName(ruleType)))
+	}
+
+	accessControlGroupNo := d.Get("access_control_group_no").(string)
+	accessControlGroup, err := GetAccessControlGroup(config, accessControlGroupNo)
+	if err != nil {
+		return err
+	}
+
+	if accessControlGroup == nil {
+		return fmt.Errorf("no matching Access Control Group: %s", accessControlGroupNo)
+	}
+
+	rule := &vserver.AddAccessControlGroupRuleParameter{
+		ProtocolTypeCode:                  ncloud.String(d.Get("protocol").(string)),
+		PortRange:                         ncloud.String(d.Get("port_range").(string)),
+		IpBlock:                           ncloud.String(d.Get("ip_block").(string)),
+		AccessControlGroupSequence:        ncloud.String(d.Get("source_access_control_group_no").(string)),
+		AccessControlGroupRuleDescription: ncloud.String(d.Get("description").(string)),
+	}
+
+	if err := addAccessControlGroupRule(d, config, ruleType, accessControlGroup, []*vserver.AddAccessControlGroupRuleParameter{rule}); err != nil {
+		return err
+	}
+
+	d.SetId(accessControlGroupSingleRuleId(accessControlGroupNo, d.Get("protocol").(string), d.Get("port_range").(string), d.Get("ip_block").(string), d.Get("source_access_control_group_no").(string)))
+	log.Printf("[INFO] ACG %s rule ID: %s", ruleType, d.Id())
+
+	return resourceNcloudAccessControlGroupSingleRuleRead(d, meta, ruleType)
+}
+
+func resourceNcloudAccessControlGroupSingleRuleRead(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	rules, err := GetAccessControlGroupRuleList(config, d.Get("access_control_group_no").(string))
+	if err != nil {
+		errBody, parseErr := GetCommonErrorBody(err)
+		if parseErr == nil && errBody.ReturnCode == "1007000" { // Acg was not found
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	rule := findAccessControlGroupSingleRule(rules, ruleType, d.Get("protocol").(string), d.Get("port_range").(string), d.Get("ip_block").(string), d.Get("source_access_control_group_no").(string))
+	if rule == nil {
+		log.Printf("[WARN] ACG %s rule (%s) not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("protocol", accessControlGroupRuleProtocol(rule))
+	d.Set("port_range", rule.PortRange)
+	d.Set("ip_block", rule.IpBlock)
+	d.Set("source_access_control_group_no", rule.AccessControlGroupSequence)
+	d.Set("description", rule.AccessControlGroupRuleDescription)
+
+	return nil
+}
+
+func resourceNcloudAccessControlGroupSingleRuleDelete(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	accessControlGroupNo := d.Get("access_control_group_no").(string)
+	accessControlGroup, err := GetAccessControlGroup(config, accessControlGroupNo)
+	if err != nil {
+		return err
+	}
+
+	if accessControlGroup == nil {
+		return nil
+	}
+
+	rule := &vserver.RemoveAccessControlGroupRuleParameter{
+		ProtocolTypeCode:           ncloud.String(d.Get("protocol").(string)),
+		PortRange:                  ncloud.String(d.Get("port_range").(string)),
+		IpBlock:                    ncloud.String(d.Get("ip_block").(string)),
+		AccessControlGroupSequence: ncloud.String(d.Get("source_access_control_group_no").(string)),
+	}
+
+	return removeAccessControlGroupRule(d, config, ruleType, accessControlGroup, []*vserver.RemoveAccessControlGroupRuleParameter{rule})
+}
+
+func resourceNcloudAccessControlGroupSingleRuleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[3] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected ACCESS_CONTROL_GROUP_NO:PROTOCOL:PORT_RANGE:IP_BLOCK_OR_SOURCE_ACCESS_CONTROL_GROUP_NO", d.Id())
+	}
+
+	d.Set("access_control_group_no", idParts[0])
+	d.Set("protocol", idParts[1])
+	d.Set("port_range", idParts[2])
+	if strings.Contains(idParts[3], "/") {
+		d.Set("ip_block", idParts[3])
+	} else {
+		d.Set("source_access_control_group_no", idParts[3])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func accessControlGroupSingleRuleId(accessControlGroupNo, protocol, portRange, ipBlock, sourceAccessControlGroupNo string) string {
+	target := ipBlock
+	if len(target) == 0 {
+		target = sourceAccessControlGroupNo
+	}
+
+	return strings.Join([]string{accessControlGroupNo, protocol, portRange, target}, ":")
+}
+
+func accessControlGroupSingleRuleName(ruleType string) string {
+	if ruleType == "inbound" {
+		return "ingress"
+	}
+	return "egress"
+}
+
+func findAccessControlGroupSingleRule(rules []*vserver.AccessControlGroupRule, ruleType, protocol, portRange, ipBlock, sourceAccessControlGroupNo string) *vserver.AccessControlGroupRule {
+	ruleTypeCode := "OTBND"
+	if ruleType == "inbound" {
+		ruleTypeCode = "INBND"
+	}
+
+	for _, r := range rules {
+		if ncloud.StringValue(r.AccessControlGroupRuleType.Code) != ruleTypeCode {
+			continue
+		}
+
+		if accessControlGroupRuleProtocol(r) == protocol &&
+			ncloud.StringValue(r.PortRange) == portRange &&
+			ncloud.StringValue(r.IpBlock) == ipBlock &&
+			ncloud.StringValue(r.AccessControlGroupSequence) == sourceAccessControlGroupNo {
+			return r
+		}
+	}
+
+	return nil
+}
+
+func accessControlGroupRuleProtocol(r *vserver.AccessControlGroupRule) string {
+	if allowedProtocolCodes[ncloud.StringValue(r.ProtocolType.Code)] {
+		return ncloud.StringValue(r.ProtocolType.Code)
+	}
+
+	return strconv.Itoa(int(ncloud.Int32Value(r.ProtocolType.Number)))
+}
@@ -0,0 +1,154 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudAccessControlGroupIngressEgressRule_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acg-single-rule-%s", acctest.RandString(5))
+	ingressName := "ncloud_access_control_group_ingress_rule.ssh"
+	sourceName := "ncloud_access_control_group_ingress_rule.source"
+	egressName := "ncloud_access_control_group_egress_rule.all"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlGroupSingleRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudAccessControlGroupIngressEgressRuleConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlGroupSingleRuleExists(ingressName),
+					testAccCheckAccessControlGroupSingleRuleExists(sourceName),
+					testAccCheckAccessControlGroupSingleRuleExists(egressName),
+					resource.TestCheckResourceAttr(ingressName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(ingressName, "port_range", "22"),
+					resource.TestCheckResourceAttr(ingressName, "ip_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttrPair(sourceName, "source_access_control_group_no", "ncloud_access_control_group.bar", "id"),
+					resource.TestCheckResourceAttr(egressName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(egressName, "port_range", "1-65535"),
+				),
+			},
+			{
+				ResourceName:      ingressName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      sourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      egressName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudAccessControlGroupIngressEgressRuleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.4.0.0/16"
+}
+
+resource "ncloud_access_control_group" "foo" {
+	name                  = "%[1]s"
+	description           = "for acc test"
+	vpc_no                = ncloud_vpc.test.id
+}
+
+resource "ncloud_access_control_group" "bar" {
+	name                  = "%[1]s-src"
+	description           = "for acc test"
+	vpc_no                = ncloud_vpc.test.id
+}
+
+resource "ncloud_access_control_group_ingress_rule" "ssh" {
+	access_control_group_no = ncloud_access_control_group.foo.id
+	protocol                = "TCP"
+	port_range              = "22"
+	ip_block                = "0.0.0.0/0"
+	description             = "%[1]s"
+}
+
+resource "ncloud_access_control_group_ingress_rule" "source" {
+	access_control_group_no        = ncloud_access_control_group.foo.id
+	protocol                       = "TCP"
+	port_range                     = "8080"
+	source_access_control_group_no = ncloud_access_control_group.bar.id
+	description                    = "%[1]s"
+}
+
+resource "ncloud_access_control_group_egress_rule" "all" {
+	access_control_group_no = ncloud_access_control_group.foo.id
+	protocol                = "TCP"
+	port_range              = "1-65535"
+	ip_block                = "0.0.0.0/0"
+	description             = "%[1]s"
+}
+`, name)
+}
+
+func testAccCheckAccessControlGroupSingleRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Access Control Group rule id is set")
+		}
+
+		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+
+		rules, err := server.GetAccessControlGroupRuleList(config, rs.Primary.Attributes["access_control_group_no"])
+		if err != nil {
+			return err
+		}
+
+		for _, r := range rules {
+			if *r.PortRange == rs.Primary.Attributes["port_range"] &&
+				*r.IpBlock == rs.Primary.Attributes["ip_block"] &&
+				*r.AccessControlGroupSequence == rs.Primary.Attributes["source_access_control_group_no"] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Entry not found: %s", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAccessControlGroupSingleRuleDestroy(s *terraform.State) error {
+	config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_access_control_group_ingress_rule" && rs.Type != "ncloud_access_control_group_egress_rule" {
+			continue
+		}
+
+		instance, err := server.GetAccessControlGroup(config, rs.Primary.Attributes["access_control_group_no"])
+		if err != nil {
+			return err
+		}
+
+		if instance != nil {
+			return fmt.Errorf("Access Control Group still exists")
+		}
+	}
+
+	return nil
+}
@@ -24,92 +24,17 @@ func ResourceNcloudAccessControlGroupRule() *schema.Resource {
 		Read:   resourceNcloudAccessControlGroupRuleRead,
 		Update: resourceNcloudAccessControlGroupRuleUpdate,
 		Delete: resourceNcloudAccessControlGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"access_control_group_no": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
-			"inbound": {
-				Type:       schema.TypeSet,
-				Optional:   true,
-				ConfigMode: schema.SchemaConfigModeAttr,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"protocol": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.All(
-								validation.StringMatch(regexp.MustCompile(`TCP|UDP|ICMP|\b([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-4])\b`), "only TCP, UDP, ICMP and 1-254 are valid values."),
-								validation.StringNotInSlice([]string{"1", "6", "17"}, false),
-							)),
-						},
-						"port_range": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
-							Default:          "",
-						},
-						"ip_block": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
-							Default:          "",
-						},
-						"source_access_control_group_no": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
-						},
-						"description": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
-							Default:          "",
-						},
-					},
-				},
-			},
-			"outbound": {
-				Type:       schema.TypeSet,
-				Optional:   true,
-				ConfigMode: schema.SchemaConfigModeAttr,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"protocol": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.All(
-								validation.StringMatch(regexp.MustCompile(`TCP|UDP|ICMP|\b([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-4])\b`), "only TCP, UDP, ICMP and 1-254 are valid values."),
-								validation.StringNotInSlice([]string{"1", "6", "17"}, false),
-							)),
-						},
-						"port_range": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
-							Default:          "",
-						},
-						"ip_block": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
-							Default:          "",
-						},
-						"source_access_control_group_no": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
-						},
-						"description": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
-							Default:          "",
-						},
-					},
-				},
-			},
+			"inbound":  accessControlGroupRuleSchema(),
+			"outbound": accessControlGroupRuleSchema(),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
@@ -119,6 +44,49 @@ func ResourceNcloudAccessControlGroupRule() *schema.Resource {
 	}
 }
 
+func accessControlGroupRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:       schema.TypeSet,
+		Optional:   true,
+		ConfigMode: schema.SchemaConfigModeAttr,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"protocol": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+						validation.StringMatch(regexp.MustCompile(`TCP|UDP|ICMP|\b([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-4])\b`), "only TCP, UDP, ICMP and 1-254 are valid values."),
+						validation.StringNotInSlice([]string{"1", "6", "17"}, false),
+					)),
+				},
+				"port_range": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
+					Default:          "",
+				},
+				"ip_block": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
+					Default:          "",
+				},
+				"source_access_control_group_no": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+				"description": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+					Default:          "",
+				},
+			},
+		},
+	}
+}
+
 func resourceNcloudAccessControlGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
@@ -186,32 +154,7 @@ func resourceNcloudAccessControlGroupRuleRead(d *schema.ResourceData, meta inter
 
 	d.Set("access_control_group_no", d.Id())
 
-	// Create empty set for getAccessControlGroupRuleList
-	iSet := schema.NewSet(schema.HashResource(ResourceNcloudAccessControlGroupRule().Schema["inbound"].Elem.(*schema.Resource)), []interface{}{})
-	oSet := schema.NewSet(schema.HashResource(ResourceNcloudAccessControlGroupRule().Schema["outbound"].Elem.(*schema.Resource)), []interface{}{})
-
-	for _, r := range rules {
-		var protocol string
-		if allowedProtocolCodes[*r.ProtocolType.Code] {
-			protocol = *r.ProtocolType.Code
-		} else {
-			protocol = strconv.Itoa(int(*r.ProtocolType.Number))
-		}
-
-		m := map[string]interface{}{
-			"protocol":                       protocol,
-			"port_range":                     *r.PortRange,
-			"ip_block":                       *r.IpBlock,
-			"source_access_control_group_no": *r.AccessControlGroupSequence,
-			"description":                    *r.AccessControlGroupRuleDescription,
-		}
-
-		if *r.AccessControlGroupRuleType.Code == "INBND" {
-			iSet.Add(m)
-		} else {
-			oSet.Add(m)
-		}
-	}
+	iSet, oSet := flattenAccessControlGroupRules(rules)
 
 	// Only set data intersection between resource and list
 	if err := d.Set("inbound", iSet.List()); err != nil {
@@ -300,12 +243,6 @@ func updateAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 		n = new(schema.Set)
 	}
 
-	os := o.(*schema.Set)
-	ns := n.(*schema.Set)
-
-	add := ns.Difference(os).List()
-	remove := os.Difference(ns).List()
-
 	accessControlGroup, err := GetAccessControlGroup(config, d.Id())
 	if err != nil {
 		return err
@@ -315,6 +252,14 @@ func updateAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 		return fmt.Errorf("no matching Access Control Group: %s", d.Id())
 	}
 
+	return replaceAccessControlGroupRule(d, config, ruleType, accessControlGroup, o.(*schema.Set), n.(*schema.Set))
+}
+
+// replaceAccessControlGroupRule removes the rules only in os and adds the rules only in ns
+func replaceAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, os, ns *schema.Set) error {
+	add := ns.Difference(os).List()
+	remove := os.Difference(ns).List()
+
 	removeAccessControlGroupRuleList := expandRemoveAccessControlGroupRule(remove)
 	addAccessControlGroupRuleList, err := expandAddAccessControlGroupRule(add)
 	if err != nil {
@@ -336,6 +281,37 @@ func updateAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 	return nil
 }
 
+func flattenAccessControlGroupRules(rules []*vserver.AccessControlGroupRule) (*schema.Set, *schema.Set) {
+	// Create empty set for getAccessControlGroupRuleList
+	iSet := schema.NewSet(schema.HashResource(accessControlGroupRuleSchema().Elem.(*schema.Resource)), []interface{}{})
+	oSet := schema.NewSet(schema.HashResource(accessControlGroupRuleSchema().Elem.(*schema.Resource)), []interface{}{})
+
+	for _, r := range rules {
+		var protocol string
+		if allowedProtocolCodes[*r.ProtocolType.Code] {
+			protocol = *r.ProtocolType.Code
+		} else {
+			protocol = strconv.Itoa(int(*r.ProtocolType.Number))
+		}
+
+		m := map[string]interface{}{
+			"protocol":                       protocol,
+			"port_range":                     *r.PortRange,
+			"ip_block":                       *r.IpBlock,
+			"source_access_control_group_no": *r.AccessControlGroupSequence,
+			"description":                    *r.AccessControlGroupRuleDescription,
+		}
+
+		if *r.AccessControlGroupRuleType.Code == "INBND" {
+			iSet.Add(m)
+		} else {
+			oSet.Add(m)
+		}
+	}
+
+	return iSet, oSet
+}
+
 func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.AddAccessControlGroupRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
@@ -347,7 +323,7 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConf
 		if ruleType == "inbound" {
 			reqParams = &vserver.AddAccessControlGroupInboundRuleRequest{
 				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
+				AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
 				VpcNo:                      accessControlGroup.VpcNo,
 				AccessControlGroupRuleList: accessControlGroupRule,
 			}
@@ -357,7 +333,7 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConf
 		} else {
 			reqParams = &vserver.AddAccessControlGroupOutboundRuleRequest{
 				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
+				AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
 				VpcNo:                      accessControlGroup.VpcNo,
 				AccessControlGroupRuleList: accessControlGroupRule,
 			}
@@ -385,7 +361,7 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConf
 
 	LogResponse("AddAccessControlGroupRule", resp)
 
-	if err = waitForVpcAccessControlGroupRunning(config, d.Id()); err != nil {
+	if err = waitForVpcAccessControlGroupRunning(config, ncloud.StringValue(accessControlGroup.AccessControlGroupNo)); err != nil {
 		return err
 	}
 
@@ -403,7 +379,7 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 		if ruleType == "inbound" {
 			reqParams = &vserver.RemoveAccessControlGroupInboundRuleRequest{
 				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
+				AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
 				VpcNo:                      accessControlGroup.VpcNo,
 				AccessControlGroupRuleList: accessControlGroupRule,
 			}
@@ -413,7 +389,7 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 		} else {
 			reqParams = &vserver.RemoveAccessControlGroupOutboundRuleRequest{
 				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
+				AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
 				VpcNo:                      accessControlGroup.VpcNo,
 				AccessControlGroupRuleList: accessControlGroupRule,
 			}
@@ -441,7 +417,7 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 
 	LogResponse("RemoveAccessControlGroupRule", resp)
 
-	if err = waitForVpcAccessControlGroupRunning(config, d.Id()); err != nil {
+	if err = waitForVpcAccessControlGroupRunning(config, ncloud.StringValue(accessControlGroup.AccessControlGroupNo)); err != nil {
 		return err
 	}
 
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -53,10 +54,13 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
 		},
 
+		CustomizeDiff: resourceNcloudBlockStorageCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"server_instance_no": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"size": {
 				Type:             schema.TypeInt,
@@ -168,7 +172,9 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 func resourceNcloudBlockStorageCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	if len(d.Get("server_instance_no").(string)) == 0 {
+	// KVM block storage can be created detached and attached by ncloud_block_storage_attachment
+	isKvm := config.SupportVPC && d.Get("hypervisor_type").(string) == BlockStorageHypervisorTypeKvm
+	if len(d.Get("server_instance_no").(string)) == 0 && !isKvm {
 		return fmt.Errorf("'server_instance_no' has to be present when ncloud_block_storage is first created.")
 	}
 
@@ -381,28 +387,48 @@ func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig)
 		return nil, err
 	}
 
-	if hypervisorType == BlockStorageHypervisorTypeKvm {
-		zone := d.Get("zone").(string)
-		if len(zone) == 0 {
-			err := fmt.Errorf("`zone` is required for KVM type")
+	// The block storage is restored into `zone` for KVM, which can differ from the zone of the original block storage
+	if snapshotNo, ok := d.GetOk("snapshot_no"); ok && hypervisorTypeOk {
+		snapshot, err := GetVpcBlockStorageSnapshotDetail(config, snapshotNo.(string))
+		if err == nil && snapshot == nil {
+			err = fmt.Errorf("fail to get block storage snapshot, %s doesn't exist", snapshotNo)
+		}
+		if err != nil {
 			LogErrorResponse("createVpcBlockStorage", err, reqParams)
 			return nil, err
 		}
 
-		server, err := GetServerInstance(config, d.Get("server_instance_no").(string))
-		if err == nil && server == nil {
-			err = fmt.Errorf("fail to get serverInstance")
-		}
-		if err != nil {
+		if ncloud.StringValue(snapshot.HypervisorType) != hypervisorType.(string) {
+			err := fmt.Errorf("`hypervisor_type` %s is different from the hypervisor type %s of snapshot %s", hypervisorType, ncloud.StringValue(snapshot.HypervisorType), snapshotNo)
 			LogErrorResponse("createVpcBlockStorage", err, reqParams)
 			return nil, err
 		}
+	}
 
-		if *server.Zone != zone {
-			err := fmt.Errorf("Different from the server's zone code %s", *server.Zone)
+	if hypervisorType == BlockStorageHypervisorTypeKvm {
+		zone := d.Get("zone").(string)
+		if len(zone) == 0 {
+			err := fmt.Errorf("`zone` is required for KVM type")
 			LogErrorResponse("createVpcBlockStorage", err, reqParams)
 			return nil, err
 		}
+
+		if serverInstanceNo, ok := d.GetOk("server_instance_no"); ok {
+			server, err := GetServerInstance(config, serverInstanceNo.(string))
+			if err == nil && server == nil {
+				err = fmt.Errorf("fail to get serverInstance")
+			}
+			if err != nil {
+				LogErrorResponse("createVpcBlockStorage", err, reqParams)
+				return nil, err
+			}
+
+			if *server.Zone != zone {
+				err := fmt.Errorf("Different from the server's zone code %s", *server.Zone)
+				LogErrorResponse("createVpcBlockStorage", err, reqParams)
+				return nil, err
+			}
+		}
 	}
 
 	LogCommonRequest("createVpcBlockStorage", reqParams)
@@ -427,7 +453,7 @@ func createVpcBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig)
 		return nil, err
 	}
 
-	if *output.StatusName == BlockStorageStatusNameDetach {
+	if *output.StatusName == BlockStorageStatusNameDetach && len(d.Get("server_instance_no").(string)) > 0 {
 		d.SetId(*instance.BlockStorageInstanceNo)
 		if err := attachBlockStorage(d, config); err != nil {
 			return nil, err
@@ -922,6 +948,27 @@ func changeVpcBlockStorageReturnProtection(d *schema.ResourceData, config *conn.
 	return nil
 }
 
+// server_instance_no is computed so that omitting it keeps the attachment of a VPC KVM block storage,
+// which can be managed by ncloud_block_storage_attachment. Omitting it still detaches other block storages.
+func resourceNcloudBlockStorageCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if diff.Id() == "" || (config.SupportVPC && diff.Get("hypervisor_type").(string) == BlockStorageHypervisorTypeKvm) {
+		return nil
+	}
+
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	if rawConfig.GetAttr("server_instance_no").IsNull() && len(diff.Get("server_instance_no").(string)) > 0 {
+		return diff.SetNew("server_instance_no", "")
+	}
+
+	return nil
+}
+
 // BlockStorage Dto for block storage
 type BlockStorage struct {
 	BlockStorageInstanceNo  *string `json:"block_storage_no,omitempty"`
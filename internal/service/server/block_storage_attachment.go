@@ -0,0 +1,145 @@
+package server
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func ResourceNcloudBlockStorageAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudBlockStorageAttachmentCreate,
+		Read:   resourceNcloudBlockStorageAttachmentRead,
+		Update: resourceNcloudBlockStorageAttachmentUpdate,
+		Delete: resourceNcloudBlockStorageAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"block_storage_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_instance_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stop_instance_before_detaching": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudBlockStorageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	// attachBlockStorage attaches the block storage of d.Id() to server_instance_no
+	d.SetId(d.Get("block_storage_no").(string))
+
+	if err := attachBlockStorage(d, config); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	if err := waitForAttachedBlockStorage(config, d.Id()); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Block Storage %s attached to Server instance %s", d.Id(), d.Get("server_instance_no").(string))
+
+	return resourceNcloudBlockStorageAttachmentRead(d, meta)
+}
+
+func resourceNcloudBlockStorageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	r, err := GetBlockStorage(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if r == nil || len(ncloud.StringValue(r.ServerInstanceNo)) == 0 {
+		log.Printf("[WARN] Block Storage %s is not attached to any server, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := d.GetOk("server_instance_no"); ok && v.(string) != ncloud.StringValue(r.ServerInstanceNo) {
+		log.Printf("[WARN] Block Storage %s is attached to other server %s, removing from state", d.Id(), ncloud.StringValue(r.ServerInstanceNo))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("block_storage_no", r.BlockStorageInstanceNo)
+	d.Set("server_instance_no", r.ServerInstanceNo)
+	d.Set("device_name", r.DeviceName)
+
+	return nil
+}
+
+func resourceNcloudBlockStorageAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceNcloudBlockStorageAttachmentRead(d, meta)
+}
+
+func resourceNcloudBlockStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	serverInstanceNo := d.Get("server_instance_no").(string)
+
+	blockStorage, err := GetBlockStorage(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Already detached. e.g. the server was terminated and detached its block storages
+	if blockStorage == nil || ncloud.StringValue(blockStorage.ServerInstanceNo) != serverInstanceNo {
+		d.SetId("")
+		return nil
+	}
+
+	if d.Get("stop_instance_before_detaching").(bool) {
+		serverInstance, err := GetServerInstance(config, serverInstanceNo)
+		if err != nil {
+			return err
+		}
+
+		if serverInstance != nil && ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
+			log.Printf("[INFO] Stopping Instance %s for detaching block storage", serverInstanceNo)
+			if err := stopThenWaitServerInstance(config, serverInstanceNo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := disconnectBlockStorage(config, blockStorage); err != nil {
+		return fmt.Errorf("error detaching Block Storage (%s) from Server instance (%s): %s", d.Id(), serverInstanceNo, err)
+	}
+
+	if err := waitForDisconnectBlockStorage(config, d.Id()); err != nil {
+		return err
+	}
+
+	if err := detachThenWaitServerInstance(config, serverInstanceNo); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
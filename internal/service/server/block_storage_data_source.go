@@ -68,7 +68,7 @@ func getBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig) ([
 }
 
 func getClassicBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorage, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +84,7 @@ func GetBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderConfig
 }
 
 func getClassicBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderConfig) ([]*BlockStorageSnapshot, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
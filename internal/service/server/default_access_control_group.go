@@ -0,0 +1,164 @@
+package server
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+)
+
+func ResourceNcloudDefaultAccessControlGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudDefaultAccessControlGroupCreate,
+		Read:   resourceNcloudDefaultAccessControlGroupRead,
+		Update: resourceNcloudDefaultAccessControlGroupUpdate,
+		Delete: resourceNcloudDefaultAccessControlGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(conn.DefaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"inbound":  accessControlGroupRuleSchema(),
+			"outbound": accessControlGroupRuleSchema(),
+			"access_control_group_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudDefaultAccessControlGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if !config.SupportVPC {
+		return NotSupportClassic("resource `ncloud_default_access_control_group`")
+	}
+
+	accessControlGroupNo, err := vpc.GetDefaultAccessControlGroup(config, d.Get("vpc_no").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(accessControlGroupNo)
+	log.Printf("[INFO] Default ACG ID: %s", d.Id())
+
+	accessControlGroup, err := GetAccessControlGroup(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if accessControlGroup == nil {
+		return fmt.Errorf("no matching Access Control Group: %s", d.Id())
+	}
+
+	// Reset the rules of the default ACG to the declared rules
+	rules, err := GetAccessControlGroupRuleList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	iSet, oSet := flattenAccessControlGroupRules(rules)
+
+	if err := replaceAccessControlGroupRule(d, config, "inbound", accessControlGroup, iSet, d.Get("inbound").(*schema.Set)); err != nil {
+		return err
+	}
+
+	if err := replaceAccessControlGroupRule(d, config, "outbound", accessControlGroup, oSet, d.Get("outbound").(*schema.Set)); err != nil {
+		return err
+	}
+
+	return resourceNcloudDefaultAccessControlGroupRead(d, meta)
+}
+
+func resourceNcloudDefaultAccessControlGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	instance, err := GetAccessControlGroup(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if !ncloud.BoolValue(instance.IsDefault) {
+		return fmt.Errorf("ACG (%s) is not the default ACG of VPC (%s)", d.Id(), ncloud.StringValue(instance.VpcNo))
+	}
+
+	rules, err := GetAccessControlGroupRuleList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("vpc_no", instance.VpcNo)
+	d.Set("access_control_group_no", instance.AccessControlGroupNo)
+	d.Set("name", instance.AccessControlGroupName)
+	d.Set("description", instance.AccessControlGroupDescription)
+	d.Set("is_default", instance.IsDefault)
+
+	iSet, oSet := flattenAccessControlGroupRules(rules)
+
+	if err := d.Set("inbound", iSet.List()); err != nil {
+		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("outbound", oSet.List()); err != nil {
+		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNcloudDefaultAccessControlGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if d.HasChange("inbound") {
+		if err := updateAccessControlGroupRule(d, config, "inbound"); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("outbound") {
+		if err := updateAccessControlGroupRule(d, config, "outbound"); err != nil {
+			return err
+		}
+	}
+
+	return resourceNcloudDefaultAccessControlGroupRead(d, meta)
+}
+
+func resourceNcloudDefaultAccessControlGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	// The default ACG is deleted with the VPC. Leave it and its rules in place.
+	log.Printf("[WARN] Default ACG (%s) is not deleted, only removed from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package server_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudDefaultAccessControlGroup_basic(t *testing.T) {
+	var AccessControlGroupRule []*vserver.AccessControlGroupRule
+	resourceName := "ncloud_default_access_control_group.default"
+	name := fmt.Sprintf("tf-default-acg-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudDefaultAccessControlGroupConfig(name, "22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlGroupRuleExists(resourceName, &AccessControlGroupRule),
+					resource.TestCheckResourceAttrPair(resourceName, "access_control_group_no", "ncloud_vpc.test", "default_access_control_group_no"),
+					resource.TestMatchResourceAttr(resourceName, "vpc_no", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "true"),
+					resource.TestCheckResourceAttr(resourceName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outbound.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudDefaultAccessControlGroupConfig(name, "2222"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlGroupRuleExists(resourceName, &AccessControlGroupRule),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "inbound.*", map[string]string{
+						"port_range": "2222",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "inbound.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudDefaultAccessControlGroupConfig(name, port string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.0.0.0/16"
+}
+
+resource "ncloud_default_access_control_group" "default" {
+	vpc_no = ncloud_vpc.test.id
+
+	inbound {
+		protocol   = "TCP"
+		ip_block   = "0.0.0.0/0"
+		port_range = "%[2]s"
+	}
+
+	outbound {
+		protocol   = "TCP"
+		ip_block   = "0.0.0.0/0"
+		port_range = "1-65535"
+	}
+}
+`, name, port)
+}
@@ -135,9 +135,7 @@ func (i *initScriptResource) Create(ctx context.Context, req resource.CreateRequ
 		reqParams.OsTypeCode = plan.OsType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateVpcInitScript", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "CreateVpcInitScript", reqParams)
 	response, err := i.config.Client.Vserver.V2Api.CreateInitScript(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -146,9 +144,7 @@ func (i *initScriptResource) Create(ctx context.Context, req resource.CreateRequ
 		)
 		return
 	}
-	tflog.Info(ctx, "CreateVpcInitScript response", map[string]any{
-		"createVpcInitScriptResponse": common.MarshalUncheckedString(response),
-	})
+	common.LogResponseContext(ctx, "CreateVpcInitScript", response)
 
 	initScriptInstance := response.InitScriptList[0]
 	plan.ID = types.StringPointerValue(initScriptInstance.InitScriptNo)
@@ -226,21 +222,14 @@ func GetInitScript(ctx context.Context, config *conn.ProviderConfig, id string)
 		InitScriptNo: ncloud.String(id),
 	}
 
-	tflog.Info(ctx, "GetInitScriptDetail", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "GetInitScriptDetail", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.GetInitScriptDetail(reqParams)
 	if err != nil {
-		tflog.Error(ctx, "GetInitScriptDetail", map[string]any{
-			"reqParams": common.MarshalUncheckedString(reqParams),
-			"error":     err,
-		})
+		common.LogErrorResponseContext(ctx, "GetInitScriptDetail", err, reqParams)
 		return nil, err
 	}
-	tflog.Info(ctx, "GetInitScriptDetail", map[string]any{
-		"resp": common.MarshalUncheckedString(resp),
-	})
+	common.LogResponseContext(ctx, "GetInitScriptDetail", resp)
 
 	if len(resp.InitScriptList) > 0 {
 		return resp.InitScriptList[0], nil
@@ -255,20 +244,13 @@ func DeleteInitScript(ctx context.Context, config *conn.ProviderConfig, id strin
 		InitScriptNoList: []*string{ncloud.String(id)},
 	}
 
-	tflog.Info(ctx, "deleteVpcInitScript", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "deleteVpcInitScript", reqParams)
 	resp, err := config.Client.Vserver.V2Api.DeleteInitScripts(reqParams)
 	if err != nil {
-		tflog.Error(ctx, "deleteVpcInitScript", map[string]any{
-			"reqParams": common.MarshalUncheckedString(reqParams),
-			"error":     err,
-		})
+		common.LogErrorResponseContext(ctx, "deleteVpcInitScript", err, reqParams)
 		return err
 	}
-	tflog.Info(ctx, "deleteVpcInitScript", map[string]any{
-		"resp": common.MarshalUncheckedString(resp),
-	})
+	common.LogResponseContext(ctx, "deleteVpcInitScript", resp)
 
 	return nil
 }
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
@@ -113,23 +112,19 @@ func (i *initScriptDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		reqParams.InitScriptName = data.Name.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetVpcInitScriptList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "GetVpcInitScriptList", reqParams)
 	initScriptResp, err := i.config.Client.Vserver.V2Api.GetInitScriptList(reqParams)
 
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(
 			"GetNatGatewayList",
-			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.MarshalUncheckedString(reqParams)),
+			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.RedactLogValue(reqParams)),
 		)
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Info(ctx, "GetVpcInitScriptList response", map[string]any{
-		"initScriptResponse": common.MarshalUncheckedString(initScriptResp),
-	})
+	common.LogResponseContext(ctx, "GetVpcInitScriptList", initScriptResp)
 
 	initScriptList, diags := flattenNatGateways(initScriptResp.InitScriptList)
 	resp.Diagnostics.Append(diags...)
@@ -20,20 +20,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifystring"
 )
 
 var (
 	_ resource.Resource                = &loginKeyResource{}
 	_ resource.ResourceWithConfigure   = &loginKeyResource{}
 	_ resource.ResourceWithImportState = &loginKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &loginKeyResource{}
 )
 
 type loginKeyResourceModel struct {
 	KeyName     types.String `tfsdk:"key_name"`
+	PublicKey   types.String `tfsdk:"public_key"`
 	PrivateKey  types.String `tfsdk:"private_key"`
 	Fingerprint types.String `tfsdk:"fingerprint"`
 	ID          types.String `tfsdk:"id"`
@@ -68,12 +72,25 @@ func (l *loginKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 				Description: "Key name to generate. If the generated key name exists, an error occurs.",
 			},
+			"public_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					verifystring.IsOpenSSHPublicKey(),
+				},
+				Description: "Public key in OpenSSH format to import. If omitted, the key pair is generated and the private key is stored in state.",
+			},
 			"private_key": schema.StringAttribute{
 				Computed:  true,
 				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"fingerprint": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"id": framework.IDAttribute(),
 		},
@@ -98,6 +115,55 @@ func (l *loginKeyResource) Configure(_ context.Context, req resource.ConfigureRe
 	l.config = config
 }
 
+func (l *loginKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan loginKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *loginKeyResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.PublicKey.IsUnknown() {
+		plan.Fingerprint = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		return
+	}
+
+	if plan.PublicKey.IsNull() {
+		// Switching from an imported public key to a generated key pair
+		if state != nil && !state.PublicKey.IsNull() {
+			resp.RequiresReplace.Append(path.Root("public_key"))
+		}
+		return
+	}
+
+	fingerprint, err := loginKeyFingerprint(plan.PublicKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid public key", err.Error())
+		return
+	}
+
+	// The fingerprint in state is the one registered in ncloud, which differs when the public key has been changed
+	// in config or the login key has been replaced out of band. Both require importing the public key again.
+	if state != nil && !loginKeyFingerprintMatches(state.Fingerprint.ValueString(), plan.PublicKey.ValueString()) {
+		resp.RequiresReplace.Append(path.Root("public_key"))
+	}
+
+	plan.Fingerprint = types.StringValue(fingerprint)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
 func (l *loginKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan loginKeyResourceModel
 	var err error
@@ -111,7 +177,13 @@ func (l *loginKeyResource) Create(ctx context.Context, req resource.CreateReques
 
 	keyName := plan.KeyName.ValueStringPointer()
 
-	if l.config.SupportVPC {
+	if !plan.PublicKey.IsNull() {
+		if l.config.SupportVPC {
+			err = importVpcLoginKey(ctx, l.config, keyName, plan.PublicKey.ValueStringPointer())
+		} else {
+			err = importClassicLoginKey(ctx, l.config, keyName, plan.PublicKey.ValueStringPointer())
+		}
+	} else if l.config.SupportVPC {
 		privatekey, err = createVpcLoginKey(ctx, l.config, keyName)
 	} else {
 
@@ -132,8 +204,16 @@ func (l *loginKeyResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	plan.refreshFromOutput(output)
-	plan.PrivateKey = types.StringValue(strings.TrimSpace(*privatekey))
+	if !plan.PublicKey.IsNull() {
+		// Keep the locally computed fingerprint of the plan
+		fingerprint := plan.Fingerprint
+		plan.refreshFromOutput(output)
+		plan.Fingerprint = fingerprint
+		plan.PrivateKey = types.StringNull()
+	} else {
+		plan.refreshFromOutput(output)
+		plan.PrivateKey = types.StringValue(strings.TrimSpace(*privatekey))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -160,6 +240,13 @@ func (l *loginKeyResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	state.refreshFromOutput(output)
 
+	// Keep the fingerprint computed locally while the registered key is the same, so only drift shows up in plan
+	if !state.PublicKey.IsNull() && loginKeyFingerprintMatches(state.Fingerprint.ValueString(), state.PublicKey.ValueString()) {
+		if fingerprint, err := loginKeyFingerprint(state.PublicKey.ValueString()); err == nil {
+			state.Fingerprint = types.StringValue(fingerprint)
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -168,6 +255,15 @@ func (l *loginKeyResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (l *loginKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan loginKeyResourceModel
+
+	// Only reached when setting the public_key of an imported login key which is already registered in ncloud
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (l *loginKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -183,7 +279,7 @@ func (l *loginKeyResource) Delete(ctx context.Context, req resource.DeleteReques
 	keyName := state.KeyName.ValueString()
 
 	tflog.Info(ctx, "DeleteLoginKey", map[string]any{
-		"KeyName": common.MarshalUncheckedString(keyName),
+		"KeyName": common.RedactLogValue(keyName),
 	})
 
 	if l.config.SupportVPC {
@@ -203,14 +299,10 @@ func (l *loginKeyResource) Delete(ctx context.Context, req resource.DeleteReques
 
 func createVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName *string) (*string, error) {
 	reqParams := &vserver.CreateLoginKeyRequest{KeyName: keyName}
-	tflog.Info(ctx, "DeleteVpcLoginKey", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "DeleteVpcLoginKey", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.CreateLoginKey(reqParams)
-	tflog.Info(ctx, "CreateVpcLoginKey response", map[string]any{
-		"createVpcLoginKeyResponse": common.MarshalUncheckedString(resp),
-	})
+	common.LogResponseContext(ctx, "CreateVpcLoginKey", resp)
 
 	return resp.PrivateKey, err
 }
@@ -248,18 +340,69 @@ func waitForNcloudLoginKeyCreation(config *conn.ProviderConfig, keyName string)
 
 func createClassicLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName *string) (*string, error) {
 	reqParams := &server.CreateLoginKeyRequest{KeyName: keyName}
-	tflog.Info(ctx, "DeleteClassicLoginKey", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "DeleteClassicLoginKey", reqParams)
 
 	resp, err := config.Client.Server.V2Api.CreateLoginKey(reqParams)
-	tflog.Info(ctx, "CreateClassicLoginKey response", map[string]any{
-		"createClassicLoginKeyResponse": common.MarshalUncheckedString(resp),
-	})
+	common.LogResponseContext(ctx, "CreateClassicLoginKey", resp)
 
 	return resp.PrivateKey, err
 }
 
+func importVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName *string, publicKey *string) error {
+	reqParams := &vserver.ImportLoginKeyRequest{KeyName: keyName, PublicKey: publicKey}
+	common.LogCommonRequestContext(ctx, "ImportVpcLoginKey", reqParams)
+
+	resp, err := config.Client.Vserver.V2Api.ImportLoginKey(reqParams)
+	if err != nil {
+		common.LogErrorResponseContext(ctx, "importVpcLoginKey", err, reqParams)
+		return err
+	}
+
+	common.LogResponseContext(ctx, "ImportVpcLoginKey", resp)
+
+	return nil
+}
+
+func importClassicLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName *string, publicKey *string) error {
+	reqParams := &server.ImportLoginKeyRequest{KeyName: keyName, PublicKey: publicKey}
+	common.LogCommonRequestContext(ctx, "ImportClassicLoginKey", reqParams)
+
+	resp, err := config.Client.Server.V2Api.ImportLoginKey(reqParams)
+	if err != nil {
+		common.LogErrorResponseContext(ctx, "importClassicLoginKey", err, reqParams)
+		return err
+	}
+
+	common.LogResponseContext(ctx, "ImportClassicLoginKey", resp)
+
+	return nil
+}
+
+// loginKeyFingerprint returns the MD5 fingerprint of the OpenSSH public key. e.g. "14:f9:1a:..."
+func loginKeyFingerprint(publicKey string) (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", fmt.Errorf("error parsing public key: %s", err)
+	}
+
+	return ssh.FingerprintLegacyMD5(key), nil
+}
+
+// loginKeyFingerprintMatches reports whether fingerprint belongs to the public key, in either MD5 or SHA256 format
+func loginKeyFingerprintMatches(fingerprint string, publicKey string) bool {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return false
+	}
+
+	fingerprint = strings.TrimSpace(fingerprint)
+	sha256Fingerprint := ssh.FingerprintSHA256(key)
+
+	return strings.EqualFold(strings.TrimPrefix(fingerprint, "MD5:"), ssh.FingerprintLegacyMD5(key)) ||
+		fingerprint == sha256Fingerprint ||
+		fingerprint == strings.TrimPrefix(sha256Fingerprint, "SHA256:")
+}
+
 type LoginKey struct {
 	KeyName     *string `json:"key_name,omitempty"`
 	Fingerprint *string `json:"fingerprint,omitempty"`
@@ -315,19 +458,15 @@ func getClassicLoginKey(config *conn.ProviderConfig, keyName string) (*LoginKey,
 
 func deleteClassicLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName string) error {
 	reqParams := &server.DeleteLoginKeyRequest{KeyName: ncloud.String(keyName)}
-	tflog.Info(ctx, "DeletClassicLoginKey", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "DeletClassicLoginKey", reqParams)
 
 	resp, err := config.Client.Server.V2Api.DeleteLoginKey(reqParams)
 	if err != nil {
-		common.LogErrorResponse("deleteClassicLoginKey", err, keyName)
+		common.LogErrorResponseContext(ctx, "deleteClassicLoginKey", err, keyName)
 		return err
 	}
 
-	tflog.Info(ctx, "DeleteClassicLoginKey response", map[string]any{
-		"deleteClassicLoginKeyResponse": common.MarshalUncheckedString(resp),
-	})
+	common.LogResponseContext(ctx, "DeleteClassicLoginKey", resp)
 
 	stateConf := &sdkresource.StateChangeConf{
 		Pending: []string{""},
@@ -359,18 +498,14 @@ func deleteClassicLoginKey(ctx context.Context, config *conn.ProviderConfig, key
 
 func deleteVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName string) error {
 	reqParams := &vserver.DeleteLoginKeysRequest{KeyNameList: []*string{ncloud.String(keyName)}}
-	tflog.Info(ctx, "DeletVpcLoginKey", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "DeletVpcLoginKey", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.DeleteLoginKeys(reqParams)
 	if err != nil {
-		common.LogErrorResponse("deleteVpcLoginKey", err, keyName)
+		common.LogErrorResponseContext(ctx, "deleteVpcLoginKey", err, keyName)
 		return err
 	}
-	tflog.Info(ctx, "DeleteVpcLoginKey response", map[string]any{
-		"deleteVpcLoginKeyResponse": common.MarshalUncheckedString(resp),
-	})
+	common.LogResponseContext(ctx, "DeleteVpcLoginKey", resp)
 
 	stateConf := &sdkresource.StateChangeConf{
 		Pending: []string{""},
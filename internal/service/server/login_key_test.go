@@ -65,6 +65,36 @@ func testAccResourceNcloudLoginKeyBasic(t *testing.T, isVpc bool) {
 	})
 }
 
+func TestAccResourceNcloudLoginKey_vpc_publicKey(t *testing.T) {
+	testKeyName := GetTestPrefix() + "-pub"
+	resourceName := "ncloud_login_key.loginkey"
+	provider := GetTestProvider(true)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccCheckLoginKeyDestroyWithProvider(state, provider)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginKeyConfigPublicKey(testKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoginKeyExistsWithProvider(resourceName, nil, provider),
+					resource.TestCheckResourceAttr(resourceName, "key_name", testKeyName),
+					resource.TestCheckResourceAttr(resourceName, "fingerprint", "14:f9:1a:d9:2f:b8:01:3b:ae:9a:70:1b:52:8e:81:50"),
+					resource.TestCheckNoResourceAttr(resourceName, "private_key"),
+				),
+			},
+			{
+				// Re-applying must not detect drift of the imported key
+				Config:   testAccLoginKeyConfigPublicKey(testKeyName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func getProvidersBasedOnVpc(isVpc bool) map[string]func() (tfprotov6.ProviderServer, error) {
 	if isVpc {
 		return ProtoV6ProviderFactories
@@ -129,3 +159,12 @@ resource "ncloud_login_key" "loginkey" {
 }
 `, keyName)
 }
+
+func testAccLoginKeyConfigPublicKey(keyName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name   = "%s"
+	public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDH1hCqysfnPxBkUzh5gzcy7EncY+07jZviavlGh2ilPP5c7nz5/8OoXWZSW5eM+AYwUnpolwa75+TprLdvetjxHm/Yr3WKmzaPJ9LFyb8Of9oKalloDVjVf+KThScJNVm8px862WDyzKjlIkoCNvnSvJupJeZAr1JohZQoC+msLIl8SaJQwzkKrHgC5AOJ1gVXv1/tup4gz/M6CBd2sApq5clC2HsfCkyDD9TquvIlBBZjF1pafsVyLd9DMFxIl2ONvOAWs9KeCuAZsdQT2rZvAcs5WKdJxI+RZJxkZ8AVSs2mKfOqARmHb+4LXgYm6Le2H5heYL00jihDsXFRoREn test"
+}
+`, keyName)
+}
@@ -0,0 +1,609 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+const (
+	MemberServerImageStatusCodeInit        = "INIT"
+	MemberServerImageStatusCodeCreate      = "CREAT"
+	MemberServerImageStatusCodeTerminating = "TERMT"
+	MemberServerImageStatusCodeDeleting    = "DEL"
+	MemberServerImageStatusCodeTerminated  = "TERMINATED"
+	ServerImageTypeCodeSelf                = "SELF"
+)
+
+func ResourceNcloudMemberServerImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudMemberServerImageCreate,
+		Read:   resourceNcloudMemberServerImageRead,
+		Delete: resourceNcloudMemberServerImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"server_instance_no": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"server_instance_no", "block_storage_snapshot_no"},
+				Description:  "Server instance No for creating member server image",
+			},
+			"block_storage_snapshot_no": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"server_instance_no", "block_storage_snapshot_no"},
+				RequiredWith: []string{"name"},
+				Description:  "Root volume block storage snapshot No for creating member server image. VPC only.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Member server image name to create. default : Ncloud assigns default values.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Member server image description to create",
+			},
+
+			"member_server_image_no": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Member server image no",
+			},
+			"original_server_image_product_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Original server image product code",
+			},
+			"platform_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Member server image platform type",
+			},
+			"instance_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Member server image status",
+			},
+			"block_storage_total_rows": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Member server image block storage total rows",
+			},
+			"block_storage_total_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Member server image block storage total size",
+			},
+			"block_storage_mapping": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Block storage mapping of the member server image. VPC only.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"order": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"block_storage_snapshot_instance_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"block_storage_snapshot_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"block_storage_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"block_storage_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"block_storage_volume_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iops": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"throughput": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_encrypted_volume": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNcloudMemberServerImageCreate(d *schema.ResourceData, meta interface{}) error {
+	var err error
+	var id *string
+	config := meta.(*conn.ProviderConfig)
+
+	if _, ok := d.GetOk("block_storage_snapshot_no"); ok {
+		id, err = createVpcMemberServerImageFromSnapshot(d, config)
+	} else if config.SupportVPC {
+		id, err = createVpcMemberServerImage(d, config)
+	} else {
+		id, err = createClassicMemberServerImage(d, config)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(ncloud.StringValue(id))
+
+	if err := waitForMemberServerImageCreation(config, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceNcloudMemberServerImageRead(d, meta)
+}
+
+func resourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	r, err := GetMemberServerImage(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if r == nil {
+		log.Printf("unable to find resource: %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(ncloud.StringValue(r.MemberServerImageNo))
+	d.Set("member_server_image_no", r.MemberServerImageNo)
+	d.Set("server_instance_no", r.OriginalServerInstanceNo)
+	d.Set("block_storage_snapshot_no", r.BlockStorageSnapshotNo)
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("original_server_image_product_code", r.OriginalServerImageProductCode)
+	d.Set("platform_type", r.PlatformType)
+	d.Set("instance_status", r.Status)
+	d.Set("block_storage_total_rows", ncloud.Int32Value(r.BlockStorageTotalRows))
+	d.Set("block_storage_total_size", ncloud.Int64Value(r.BlockStorageTotalSize))
+
+	if err := d.Set("block_storage_mapping", flattenBlockStorageMappings(r.BlockStorageMappingList)); err != nil {
+		log.Printf("[WARN] Error setting block_storage_mapping for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNcloudMemberServerImageDelete(d *schema.ResourceData, meta interface{}) error {
+	var err error
+	config := meta.(*conn.ProviderConfig)
+
+	// Images created from a block storage snapshot are server images, which Read records with their snapshot
+	if _, ok := d.GetOk("block_storage_snapshot_no"); ok {
+		err = deleteVpcServerImage(config, d.Id())
+	} else if config.SupportVPC {
+		err = deleteVpcMemberServerImage(config, d.Id())
+	} else {
+		err = deleteClassicMemberServerImage(config, d.Id())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return waitForMemberServerImageDeletion(config, d.Id(), d.Timeout(schema.TimeoutDelete))
+}
+
+func createClassicMemberServerImage(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+	reqParams := &server.CreateMemberServerImageRequest{
+		ServerInstanceNo:             ncloud.String(d.Get("server_instance_no").(string)),
+		MemberServerImageName:        StringPtrOrNil(d.GetOk("name")),
+		MemberServerImageDescription: StringPtrOrNil(d.GetOk("description")),
+	}
+
+	LogCommonRequest("createClassicMemberServerImage", reqParams)
+
+	resp, err := config.Client.Server.V2Api.CreateMemberServerImage(reqParams)
+	if err != nil {
+		LogErrorResponse("createClassicMemberServerImage", err, reqParams)
+		return nil, err
+	}
+	LogResponse("createClassicMemberServerImage", resp)
+
+	if resp == nil || len(resp.MemberServerImageList) < 1 {
+		err := fmt.Errorf("response invalid")
+		LogErrorResponse("createClassicMemberServerImage", err, reqParams)
+		return nil, err
+	}
+
+	return resp.MemberServerImageList[0].MemberServerImageNo, nil
+}
+
+func createVpcMemberServerImage(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+	reqParams := &vserver.CreateMemberServerImageInstanceRequest{
+		RegionCode:                   &config.RegionCode,
+		ServerInstanceNo:             ncloud.String(d.Get("server_instance_no").(string)),
+		MemberServerImageName:        StringPtrOrNil(d.GetOk("name")),
+		MemberServerImageDescription: StringPtrOrNil(d.GetOk("description")),
+	}
+
+	LogCommonRequest("createVpcMemberServerImage", reqParams)
+
+	resp, err := config.Client.Vserver.V2Api.CreateMemberServerImageInstance(reqParams)
+	if err != nil {
+		LogErrorResponse("createVpcMemberServerImage", err, reqParams)
+		return nil, err
+	}
+	LogResponse("createVpcMemberServerImage", resp)
+
+	if resp == nil || len(resp.MemberServerImageInstanceList) < 1 {
+		err := fmt.Errorf("response invalid")
+		LogErrorResponse("createVpcMemberServerImage", err, reqParams)
+		return nil, err
+	}
+
+	return resp.MemberServerImageInstanceList[0].MemberServerImageInstanceNo, nil
+}
+
+func createVpcMemberServerImageFromSnapshot(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+	if !config.SupportVPC {
+		return nil, NotSupportClassic("`block_storage_snapshot_no` of resource `ncloud_member_server_image`")
+	}
+
+	reqParams := &vserver.CreateServerImageFromSnapshotRequest{
+		RegionCode:             &config.RegionCode,
+		ServerImageName:        ncloud.String(d.Get("name").(string)),
+		ServerImageDescription: StringPtrOrNil(d.GetOk("description")),
+		BlockStorageList: []*vserver.BlockStorage{
+			{
+				Order:              ncloud.Int32(0),
+				SnapshotInstanceNo: ncloud.String(d.Get("block_storage_snapshot_no").(string)),
+			},
+		},
+	}
+
+	LogCommonRequest("createVpcMemberServerImageFromSnapshot", reqParams)
+
+	resp, err := config.Client.Vserver.V2Api.CreateServerImageFromSnapshot(reqParams)
+	if err != nil {
+		LogErrorResponse("createVpcMemberServerImageFromSnapshot", err, reqParams)
+		return nil, err
+	}
+	LogResponse("createVpcMemberServerImageFromSnapshot", resp)
+
+	if resp == nil || len(resp.ServerImageList) < 1 {
+		err := fmt.Errorf("response invalid")
+		LogErrorResponse("createVpcMemberServerImageFromSnapshot", err, reqParams)
+		return nil, err
+	}
+
+	return resp.ServerImageList[0].ServerImageNo, nil
+}
+
+func waitForMemberServerImageCreation(config *conn.ProviderConfig, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{MemberServerImageStatusCodeInit},
+		Target:  []string{MemberServerImageStatusCodeCreate},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := GetMemberServerImage(config, id)
+			if err != nil {
+				return 0, "", err
+			}
+
+			if instance == nil {
+				return 0, "", fmt.Errorf("fail to get member server image, %s doesn't exist", id)
+			}
+
+			return instance, ncloud.StringValue(instance.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for MemberServerImage state to be \"CREAT\": %s", err)
+	}
+
+	return nil
+}
+
+func deleteClassicMemberServerImage(config *conn.ProviderConfig, id string) error {
+	reqParams := &server.DeleteMemberServerImagesRequest{
+		MemberServerImageNoList: []*string{ncloud.String(id)},
+	}
+
+	LogCommonRequest("deleteClassicMemberServerImage", reqParams)
+
+	resp, err := config.Client.Server.V2Api.DeleteMemberServerImages(reqParams)
+	if err != nil {
+		LogErrorResponse("deleteClassicMemberServerImage", err, reqParams)
+		return err
+	}
+	LogResponse("deleteClassicMemberServerImage", resp)
+
+	return nil
+}
+
+func deleteVpcMemberServerImage(config *conn.ProviderConfig, id string) error {
+	reqParams := &vserver.DeleteMemberServerImageInstancesRequest{
+		RegionCode:                      &config.RegionCode,
+		MemberServerImageInstanceNoList: []*string{ncloud.String(id)},
+	}
+
+	LogCommonRequest("deleteVpcMemberServerImage", reqParams)
+
+	resp, err := config.Client.Vserver.V2Api.DeleteMemberServerImageInstances(reqParams)
+	if err != nil {
+		LogErrorResponse("deleteVpcMemberServerImage", err, reqParams)
+		return err
+	}
+	LogResponse("deleteVpcMemberServerImage", resp)
+
+	return nil
+}
+
+func deleteVpcServerImage(config *conn.ProviderConfig, id string) error {
+	reqParams := &vserver.DeleteServerImageRequest{
+		RegionCode:        &config.RegionCode,
+		ServerImageNoList: []*string{ncloud.String(id)},
+	}
+
+	LogCommonRequest("deleteVpcServerImage", reqParams)
+
+	resp, err := config.Client.Vserver.V2Api.DeleteServerImage(reqParams)
+	if err != nil {
+		LogErrorResponse("deleteVpcServerImage", err, reqParams)
+		return err
+	}
+	LogResponse("deleteVpcServerImage", resp)
+
+	return nil
+}
+
+func waitForMemberServerImageDeletion(config *conn.ProviderConfig, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		// An image deleted while still being created can report INIT before it goes away
+		Pending: []string{MemberServerImageStatusCodeInit, MemberServerImageStatusCodeCreate, MemberServerImageStatusCodeTerminating, MemberServerImageStatusCodeDeleting},
+		Target:  []string{MemberServerImageStatusCodeTerminated},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := GetMemberServerImage(config, id)
+			if err != nil {
+				return 0, "", err
+			}
+
+			if instance == nil { // Instance is terminated.
+				return instance, MemberServerImageStatusCodeTerminated, nil
+			}
+
+			return instance, ncloud.StringValue(instance.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for MemberServerImage state to be \"TERMINATED\": %s", err)
+	}
+
+	return nil
+}
+
+func GetMemberServerImage(config *conn.ProviderConfig, id string) (*MemberServerImage, error) {
+	if config.SupportVPC {
+		return getVpcMemberServerImageDetail(config, id)
+	}
+
+	return getClassicMemberServerImageDetail(config, id)
+}
+
+func getClassicMemberServerImageDetail(config *conn.ProviderConfig, id string) (*MemberServerImage, error) {
+	reqParams := &server.GetMemberServerImageListRequest{
+		MemberServerImageNoList: []*string{ncloud.String(id)},
+	}
+
+	LogCommonRequest("getClassicMemberServerImageDetail", reqParams)
+
+	resp, err := config.Client.Server.V2Api.GetMemberServerImageList(reqParams)
+	if err != nil {
+		LogErrorResponse("getClassicMemberServerImageDetail", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getClassicMemberServerImageDetail", resp)
+
+	if len(resp.MemberServerImageList) < 1 {
+		return nil, nil
+	}
+
+	r := resp.MemberServerImageList[0]
+
+	return &MemberServerImage{
+		MemberServerImageNo:            r.MemberServerImageNo,
+		Name:                           r.MemberServerImageName,
+		Description:                    r.MemberServerImageDescription,
+		OriginalServerInstanceNo:       r.OriginalServerInstanceNo,
+		OriginalServerImageProductCode: r.OriginalServerImageProductCode,
+		PlatformType:                   GetCodePtrByCommonCode(r.MemberServerImagePlatformType),
+		Status:                         GetCodePtrByCommonCode(r.MemberServerImageStatus),
+		BlockStorageTotalRows:          r.MemberServerImageBlockStorageTotalRows,
+		BlockStorageTotalSize:          r.MemberServerImageBlockStorageTotalSize,
+	}, nil
+}
+
+func getVpcMemberServerImageDetail(config *conn.ProviderConfig, id string) (*MemberServerImage, error) {
+	reqParams := &vserver.GetMemberServerImageInstanceDetailRequest{
+		RegionCode:                  &config.RegionCode,
+		MemberServerImageInstanceNo: ncloud.String(id),
+	}
+
+	LogCommonRequest("getVpcMemberServerImageDetail", reqParams)
+
+	resp, err := config.Client.Vserver.V2Api.GetMemberServerImageInstanceDetail(reqParams)
+	if err != nil {
+		LogErrorResponse("getVpcMemberServerImageDetail", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getVpcMemberServerImageDetail", resp)
+
+	if len(resp.MemberServerImageInstanceList) < 1 {
+		// Images created from snapshots are only available as server images
+		serverImage, err := getVpcServerImageDetail(config, id)
+		if err != nil || serverImage == nil {
+			return nil, err
+		}
+		if ncloud.StringValue(GetCodePtrByCommonCode(serverImage.ServerImageType)) != ServerImageTypeCodeSelf {
+			return nil, fmt.Errorf("server image %s is not a member server image", id)
+		}
+		return convertVpcServerImageToMemberServerImage(serverImage), nil
+	}
+
+	r := resp.MemberServerImageInstanceList[0]
+	instance := &MemberServerImage{
+		MemberServerImageNo:            r.MemberServerImageInstanceNo,
+		Name:                           r.MemberServerImageName,
+		Description:                    r.MemberServerImageDescription,
+		OriginalServerInstanceNo:       r.OriginalServerInstanceNo,
+		OriginalServerImageProductCode: r.OriginalServerImageProductCode,
+		Status:                         GetCodePtrByCommonCode(r.MemberServerImageInstanceStatus),
+		BlockStorageTotalRows:          r.MemberServerImageBlockStorageTotalRows,
+		BlockStorageTotalSize:          r.MemberServerImageBlockStorageTotalSize,
+	}
+
+	// The block storage mapping is only available from the server image, which shares the number
+	if ncloud.StringValue(instance.Status) == MemberServerImageStatusCodeCreate {
+		serverImage, err := getVpcServerImageDetail(config, id)
+		if err != nil {
+			return nil, err
+		}
+		if serverImage != nil {
+			instance.BlockStorageMappingList = serverImage.BlockStorageMappingList
+		}
+	}
+
+	return instance, nil
+}
+
+func getVpcServerImageDetail(config *conn.ProviderConfig, id string) (*vserver.ServerImage, error) {
+	reqParams := &vserver.GetServerImageDetailRequest{
+		RegionCode:    &config.RegionCode,
+		ServerImageNo: ncloud.String(id),
+	}
+
+	LogCommonRequest("getVpcServerImageDetail", reqParams)
+
+	resp, err := config.Client.Vserver.V2Api.GetServerImageDetail(reqParams)
+	if err != nil {
+		LogErrorResponse("getVpcServerImageDetail", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getVpcServerImageDetail", resp)
+
+	if len(resp.ServerImageList) < 1 {
+		return nil, nil
+	}
+
+	return resp.ServerImageList[0], nil
+}
+
+func convertVpcServerImageToMemberServerImage(r *vserver.ServerImage) *MemberServerImage {
+	instance := &MemberServerImage{
+		MemberServerImageNo:            r.ServerImageNo,
+		Name:                           r.ServerImageName,
+		Description:                    r.ServerImageDescription,
+		OriginalServerImageProductCode: r.ServerImageProductCode,
+		Status:                         GetCodePtrByCommonCode(r.ServerImageStatus),
+		BlockStorageMappingList:        r.BlockStorageMappingList,
+		BlockStorageTotalRows:          ncloud.Int32(int32(len(r.BlockStorageMappingList))),
+	}
+
+	var totalSize int64
+	for _, m := range r.BlockStorageMappingList {
+		totalSize += ncloud.Int64Value(m.BlockStorageSize)
+
+		// The root volume is the snapshot the image was created from
+		if ncloud.Int32Value(m.Order) == 0 && m.BlockStorageSnapshotInstanceNo != nil {
+			instance.BlockStorageSnapshotNo = ncloud.String(strconv.Itoa(int(*m.BlockStorageSnapshotInstanceNo)))
+		}
+	}
+	instance.BlockStorageTotalSize = ncloud.Int64(totalSize)
+
+	return instance
+}
+
+func flattenBlockStorageMappings(mappings []*vserver.BlockStorageMapping) []map[string]interface{} {
+	var list []map[string]interface{}
+
+	for _, m := range mappings {
+		mapping := map[string]interface{}{
+			"order":                       ncloud.Int32Value(m.Order),
+			"block_storage_snapshot_name": ncloud.StringValue(m.BlockStorageSnapshotName),
+			"block_storage_size":          ncloud.Int64Value(m.BlockStorageSize),
+			"block_storage_name":          ncloud.StringValue(m.BlockStorageName),
+			"block_storage_volume_type":   ncloud.StringValue(GetCodePtrByCommonCode(m.BlockStorageVolumeType)),
+			"iops":                        ncloud.Int32Value(m.Iops),
+			"throughput":                  ncloud.Int64Value(m.Throughput),
+			"is_encrypted_volume":         ncloud.BoolValue(m.IsEncryptedVolume),
+		}
+		if m.BlockStorageSnapshotInstanceNo != nil {
+			mapping["block_storage_snapshot_instance_no"] = fmt.Sprintf("%d", *m.BlockStorageSnapshotInstanceNo)
+		}
+
+		list = append(list, mapping)
+	}
+
+	return list
+}
+
+// MemberServerImage Dto for member server image
+type MemberServerImage struct {
+	MemberServerImageNo            *string
+	Name                           *string
+	Description                    *string
+	OriginalServerInstanceNo       *string
+	OriginalServerImageProductCode *string
+	Status                         *string
+	BlockStorageTotalRows          *int32
+	BlockStorageTotalSize          *int64
+	// CLASSIC only
+	PlatformType *string
+	// VPC only
+	BlockStorageMappingList []*vserver.BlockStorageMapping
+	BlockStorageSnapshotNo  *string
+}
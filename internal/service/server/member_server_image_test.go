@@ -0,0 +1,157 @@
+package server_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudMemberServerImage_vpc_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-image-%s", acctest.RandString(5))
+	resourceName := "ncloud_member_server_image.image"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMemberServerImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberServerImageVpcConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "member_server_image_no", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.server", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "instance_status", "CREAT"),
+					resource.TestCheckResourceAttr(resourceName, "block_storage_mapping.0.order", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudMemberServerImage_vpc_fromSnapshot(t *testing.T) {
+	name := fmt.Sprintf("tf-image-%s", acctest.RandString(5))
+	resourceName := "ncloud_member_server_image.from_snapshot"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMemberServerImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberServerImageVpcConfigFromSnapshot(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-snap"),
+					resource.TestCheckResourceAttr(resourceName, "instance_status", "CREAT"),
+					resource.TestCheckResourceAttrPair(resourceName, "block_storage_mapping.0.block_storage_snapshot_instance_no", "ncloud_block_storage_snapshot.root", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMemberServerImageDestroy(s *terraform.State) error {
+	config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_member_server_image" {
+			continue
+		}
+		image, err := server.GetMemberServerImage(config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if image != nil {
+			return fmt.Errorf("unterminated member server image : %s", *image.MemberServerImageNo)
+		}
+	}
+
+	return nil
+}
+
+func testAccMemberServerImageVpcConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+data "ncloud_server_image_numbers" "server_images" {
+	hypervisor_type = "KVM"
+	filter {
+		name = "name"
+		values = ["ubuntu-22.04-base"]
+	}
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_member_server_image" "image" {
+	server_instance_no = ncloud_server.server.id
+	name = "%[1]s"
+	description = "Terraform test member server image"
+}
+`, name)
+}
+
+func testAccMemberServerImageVpcConfigFromSnapshot(name string) string {
+	return testAccMemberServerImageVpcConfig(name) + fmt.Sprintf(`
+data "ncloud_block_storage" "root" {
+	server_instance_no = ncloud_server.server.id
+	filter {
+		name = "type"
+		values = ["BASIC"]
+	}
+}
+
+resource "ncloud_block_storage_snapshot" "root" {
+	block_storage_instance_no = data.ncloud_block_storage.root.id
+	name = "%[1]s-root"
+}
+
+resource "ncloud_member_server_image" "from_snapshot" {
+	block_storage_snapshot_no = ncloud_block_storage_snapshot.root.id
+	name = "%[1]s-snap"
+}
+`, name)
+}
@@ -68,6 +68,14 @@ func ResourceNcloudNetworkInterface() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"secondary_private_ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+				},
+			},
 			"description": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -138,6 +146,7 @@ func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 	d.Set("server_instance_no", instance.InstanceNo)
 	d.Set("status", instance.NetworkInterfaceStatus.Code)
 	d.Set("access_control_groups", instance.AccessControlGroupNoList)
+	d.Set("secondary_private_ips", instance.SecondaryIpList)
 	d.Set("is_default", instance.IsDefault)
 
 	if instance.InstanceType != nil {
@@ -153,13 +162,13 @@ func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 		if len(o.(string)) > 0 {
-			if err := detachNetworkInterface(d, config, o.(string)); err != nil {
+			if err := detachNetworkInterface(config, d.Id(), d.Get("subnet_no").(string), o.(string)); err != nil {
 				return err
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := attachNetworkInterface(d, config); err != nil {
+			if err := attachNetworkInterface(config, d.Id(), d.Get("subnet_no").(string), n.(string)); err != nil {
 				return err
 			}
 		}
@@ -190,9 +199,75 @@ func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("secondary_private_ips") {
+		o, n := d.GetChange("secondary_private_ips")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		unassignIpList := ExpandStringInterfaceList(os.Difference(ns).List())
+		assignIpList := ExpandStringInterfaceList(ns.Difference(os).List())
+
+		// First do unassign to allow moving an IP between addresses of the same network interface
+		if len(unassignIpList) > 0 {
+			if err := unassignNetworkInterfaceSecondaryIps(config, d.Id(), unassignIpList); err != nil {
+				return err
+			}
+		}
+
+		if len(assignIpList) > 0 {
+			if err := assignNetworkInterfaceSecondaryIps(config, d.Id(), assignIpList); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceNcloudNetworkInterfaceRead(d, meta)
 }
 
+func assignNetworkInterfaceSecondaryIps(config *conn.ProviderConfig, id string, secondaryIpList []*string) error {
+	reqParams := &vserver.AssignSecondaryIpsRequest{
+		RegionCode:         &config.RegionCode,
+		NetworkInterfaceNo: ncloud.String(id),
+		SecondaryIpList:    secondaryIpList,
+	}
+
+	LogCommonRequest("AssignSecondaryIps", reqParams)
+	resp, err := config.Client.Vserver.V2Api.AssignSecondaryIps(reqParams)
+	if err != nil {
+		LogErrorResponse("AssignSecondaryIps", err, reqParams)
+		return err
+	}
+	LogResponse("AssignSecondaryIps", resp)
+
+	if err = waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func unassignNetworkInterfaceSecondaryIps(config *conn.ProviderConfig, id string, secondaryIpList []*string) error {
+	reqParams := &vserver.UnassignSecondaryIpsRequest{
+		RegionCode:         &config.RegionCode,
+		NetworkInterfaceNo: ncloud.String(id),
+		SecondaryIpList:    secondaryIpList,
+	}
+
+	LogCommonRequest("UnassignSecondaryIps", reqParams)
+	resp, err := config.Client.Vserver.V2Api.UnassignSecondaryIps(reqParams)
+	if err != nil {
+		LogErrorResponse("UnassignSecondaryIps", err, reqParams)
+		return err
+	}
+	LogResponse("UnassignSecondaryIps", resp)
+
+	if err = waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *conn.ProviderConfig, accessControlGroupNoList []*string) error {
 	var resp *vserver.RemoveNetworkInterfaceAccessControlGroupResponse
 	var reqParams *vserver.RemoveNetworkInterfaceAccessControlGroupRequest
@@ -326,6 +401,10 @@ func createVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConf
 		Ip:                          StringPtrOrNil(d.GetOk("private_ip")),
 	}
 
+	if v, ok := d.GetOk("secondary_private_ips"); ok {
+		reqParams.SecondaryIpList = ExpandStringInterfaceList(v.(*schema.Set).List())
+	}
+
 	LogCommonRequest("createVpcNetworkInterface", reqParams)
 	resp, err := config.Client.Vserver.V2Api.CreateNetworkInterface(reqParams)
 	if err != nil {
@@ -366,11 +445,11 @@ func deleteVpcNetworkInterface(config *conn.ProviderConfig, id string) error {
 	return nil
 }
 
-func attachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachNetworkInterface(config *conn.ProviderConfig, id string, subnetNo string, serverInstanceNo string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = attachVpcNetworkInterface(d, config)
+		err = attachVpcNetworkInterface(config, id, subnetNo, serverInstanceNo)
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -379,39 +458,39 @@ func attachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig)
 		return err
 	}
 
-	_ = waitForPublicIpDisassociate(d, config)
+	_ = waitForPublicIpDisassociate(config, serverInstanceNo)
 
 	return nil
 }
 
-func attachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func attachVpcNetworkInterface(config *conn.ProviderConfig, id string, subnetNo string, serverInstanceNo string) error {
 	reqParams := &vserver.AttachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
-		NetworkInterfaceNo: ncloud.String(d.Id()),
-		SubnetNo:           ncloud.String(d.Get("subnet_no").(string)),
-		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
+		NetworkInterfaceNo: ncloud.String(id),
+		SubnetNo:           ncloud.String(subnetNo),
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
 	LogCommonRequest("attachVpcNetworkInterface", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.AttachNetworkInterface(reqParams)
 	if err != nil {
-		LogErrorResponse("attachVpcNetworkInterface", err, d.Id())
+		LogErrorResponse("attachVpcNetworkInterface", err, id)
 		return err
 	}
 	LogCommonResponse("attachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForNetworkInterfaceAttachment(config, d.Id()); err != nil {
+	if err := waitForNetworkInterfaceAttachment(config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func waitForPublicIpDisassociate(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func waitForPublicIpDisassociate(config *conn.ProviderConfig, serverInstanceNo string) error {
 	reqParams := &vserver.GetServerInstanceDetailRequest{
 		RegionCode:       &config.RegionCode,
-		ServerInstanceNo: ncloud.String(d.Get("server_instance_no").(string)),
+		ServerInstanceNo: ncloud.String(serverInstanceNo),
 	}
 
 	resp, err := config.Client.Vserver.V2Api.GetServerInstanceDetail(reqParams)
@@ -432,11 +511,11 @@ func waitForPublicIpDisassociate(d *schema.ResourceData, config *conn.ProviderCo
 	return nil
 }
 
-func detachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
+func detachNetworkInterface(config *conn.ProviderConfig, id string, subnetNo string, serverInstanceNo string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = detachVpcNetworkInterface(d, config, serverInstanceNo)
+		err = detachVpcNetworkInterface(config, id, subnetNo, serverInstanceNo)
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -448,11 +527,11 @@ func detachNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig,
 	return nil
 }
 
-func detachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
+func detachVpcNetworkInterface(config *conn.ProviderConfig, id string, subnetNo string, serverInstanceNo string) error {
 	reqParams := &vserver.DetachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
-		NetworkInterfaceNo: ncloud.String(d.Id()),
-		SubnetNo:           ncloud.String(d.Get("subnet_no").(string)),
+		NetworkInterfaceNo: ncloud.String(id),
+		SubnetNo:           ncloud.String(subnetNo),
 		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
@@ -460,12 +539,12 @@ func detachVpcNetworkInterface(d *schema.ResourceData, config *conn.ProviderConf
 
 	resp, err := config.Client.Vserver.V2Api.DetachNetworkInterface(reqParams)
 	if err != nil {
-		LogErrorResponse("detachVpcNetworkInterface", err, d.Id())
+		LogErrorResponse("detachVpcNetworkInterface", err, id)
 		return err
 	}
 	LogCommonResponse("detachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
+	if err := waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
 		return err
 	}
 
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func ResourceNcloudNetworkInterfaceAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudNetworkInterfaceAttachmentCreate,
+		Read:   resourceNcloudNetworkInterfaceAttachmentRead,
+		Delete: resourceNcloudNetworkInterfaceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_instance_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"order": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"subnet_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if !config.SupportVPC {
+		return NotSupportClassic("resource `ncloud_network_interface_attachment`")
+	}
+
+	networkInterfaceNo := d.Get("network_interface_no").(string)
+	serverInstanceNo := d.Get("server_instance_no").(string)
+
+	networkInterface, err := GetNetworkInterface(config, networkInterfaceNo)
+	if err != nil {
+		return err
+	}
+
+	if networkInterface == nil {
+		return fmt.Errorf("no matching Network Interface: %s", networkInterfaceNo)
+	}
+
+	// The attach API does not take the device order; the lowest free device of the server is assigned.
+	// Fail before attaching when it differs, so that nothing is left to replace on the next apply.
+	if v, ok := d.GetOk("order"); ok {
+		order, err := nextNetworkInterfaceOrder(config, serverInstanceNo)
+		if err != nil {
+			return err
+		}
+
+		if order != v.(int) {
+			return fmt.Errorf("Network Interface %s would be attached at order %d of Server instance %s, expected %d", networkInterfaceNo, order, serverInstanceNo, v.(int))
+		}
+	}
+
+	if err := attachNetworkInterface(config, networkInterfaceNo, ncloud.StringValue(networkInterface.SubnetNo), serverInstanceNo); err != nil {
+		return err
+	}
+
+	d.SetId(networkInterfaceNo)
+	log.Printf("[INFO] Network Interface %s attached to Server instance %s", d.Id(), serverInstanceNo)
+
+	return resourceNcloudNetworkInterfaceAttachmentRead(d, meta)
+}
+
+func resourceNcloudNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	networkInterface, err := GetNetworkInterface(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if networkInterface == nil || len(ncloud.StringValue(networkInterface.InstanceNo)) == 0 {
+		log.Printf("[WARN] Network Interface %s is not attached to any server, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := d.GetOk("server_instance_no"); ok && v.(string) != ncloud.StringValue(networkInterface.InstanceNo) {
+		log.Printf("[WARN] Network Interface %s is attached to other server %s, removing from state", d.Id(), ncloud.StringValue(networkInterface.InstanceNo))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_interface_no", networkInterface.NetworkInterfaceNo)
+	d.Set("server_instance_no", networkInterface.InstanceNo)
+	d.Set("subnet_no", networkInterface.SubnetNo)
+	d.Set("device_name", networkInterface.DeviceName)
+
+	if deviceName := ncloud.StringValue(networkInterface.DeviceName); len(deviceName) > 0 {
+		order, err := networkInterfaceOrder(deviceName)
+		if err != nil {
+			return err
+		}
+		d.Set("order", order)
+	}
+
+	return nil
+}
+
+func resourceNcloudNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	serverInstanceNo := d.Get("server_instance_no").(string)
+
+	networkInterface, err := GetNetworkInterface(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Already detached. e.g. the server was terminated or the network interface was moved to other server
+	if networkInterface == nil || ncloud.StringValue(networkInterface.InstanceNo) != serverInstanceNo {
+		d.SetId("")
+		return nil
+	}
+
+	if err := detachNetworkInterface(config, d.Id(), ncloud.StringValue(networkInterface.SubnetNo), serverInstanceNo); err != nil {
+		return fmt.Errorf("error detaching Network Interface (%s) from Server instance (%s): %s", d.Id(), serverInstanceNo, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func networkInterfaceOrder(deviceName string) (int, error) {
+	order, err := strconv.Atoi(regexp.MustCompile("[0-9]+").FindString(deviceName))
+	if err != nil {
+		return 0, fmt.Errorf("error parsing network interface device name: %s", deviceName)
+	}
+	return order, nil
+}
+
+// nextNetworkInterfaceOrder returns the order of the lowest free device of the server, which the next attached
+// Network Interface gets
+func nextNetworkInterfaceOrder(config *conn.ProviderConfig, serverInstanceNo string) (int, error) {
+	reqParams := &vserver.GetNetworkInterfaceListRequest{
+		RegionCode: &config.RegionCode,
+		InstanceNo: ncloud.String(serverInstanceNo),
+	}
+
+	LogCommonRequest("nextNetworkInterfaceOrder", reqParams)
+	resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(reqParams)
+	if err != nil {
+		LogErrorResponse("nextNetworkInterfaceOrder", err, reqParams)
+		return 0, err
+	}
+	LogResponse("nextNetworkInterfaceOrder", resp)
+
+	used := map[int]bool{}
+	for _, r := range resp.NetworkInterfaceList {
+		if deviceName := ncloud.StringValue(r.DeviceName); len(deviceName) > 0 {
+			order, err := networkInterfaceOrder(deviceName)
+			if err != nil {
+				return 0, err
+			}
+			used[order] = true
+		}
+	}
+
+	order := 1
+	for used[order] {
+		order++
+	}
+
+	return order, nil
+}
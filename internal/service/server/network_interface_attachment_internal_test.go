@@ -0,0 +1,93 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/mockapi"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestNextNetworkInterfaceOrder(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client, err := (&conn.Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: server.Endpoints(),
+	}).Client("public")
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR", Client: client}
+
+	vpcs, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{Ipv4CidrBlock: ncloud.String("10.0.0.0/16")})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	vpcNo := vpcs.VpcList[0].VpcNo
+	acls, _ := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: vpcNo})
+	subnets, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		VpcNo:          vpcNo,
+		ZoneCode:       ncloud.String("KR-2"),
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		NetworkAclNo:   acls.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PRIVATE"),
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	cases := []struct {
+		Name     string
+		Orders   []int32
+		Expected int
+	}{
+		{
+			Name:     "default network interface only",
+			Orders:   []int32{0},
+			Expected: 1,
+		},
+		{
+			Name:     "lowest free order",
+			Orders:   []int32{0, 2},
+			Expected: 1,
+		},
+		{
+			Name:     "after the attached network interfaces",
+			Orders:   []int32{0, 1},
+			Expected: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var networkInterfaces []*vserver.NetworkInterfaceParameter
+			for _, order := range tc.Orders {
+				networkInterfaces = append(networkInterfaces, &vserver.NetworkInterfaceParameter{NetworkInterfaceOrder: ncloud.Int32(order)})
+			}
+
+			resp, err := client.Vserver.V2Api.CreateServerInstances(&vserver.CreateServerInstancesRequest{
+				VpcNo:                vpcNo,
+				SubnetNo:             subnets.SubnetList[0].SubnetNo,
+				NetworkInterfaceList: networkInterfaces,
+			})
+			if err != nil {
+				t.Fatalf("Got error: %s", err)
+			}
+
+			order, err := nextNetworkInterfaceOrder(config, ncloud.StringValue(resp.ServerInstanceList[0].ServerInstanceNo))
+			if err != nil {
+				t.Fatalf("Got error: %s", err)
+			}
+			if order != tc.Expected {
+				t.Fatalf("Expected order %d but %d", tc.Expected, order)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package server_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudNetworkInterfaceAttachment_vpc_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-nic-attach-%s", acctest.RandString(5))
+	resourceName := "ncloud_network_interface_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInterfaceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkInterfaceAttachmentVpcConfig(name, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_no", "ncloud_network_interface.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.active", "id"),
+					resource.TestCheckResourceAttr(resourceName, "order", "1"),
+					resource.TestMatchResourceAttr(resourceName, "device_name", regexp.MustCompile(`^eth1$`)),
+				),
+			},
+			{
+				// Failover the network interface to the standby server
+				Config: testAccNetworkInterfaceAttachmentVpcConfig(name, "standby"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.standby", "id"),
+					resource.TestCheckResourceAttr(resourceName, "order", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInterfaceAttachmentDestroy(s *terraform.State) error {
+	config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_network_interface_attachment" {
+			continue
+		}
+
+		instance, err := server.GetNetworkInterface(config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if instance != nil && *instance.InstanceNo == rs.Primary.Attributes["server_instance_no"] {
+			return fmt.Errorf("Network Interface %s is still attached to %s", rs.Primary.ID, *instance.InstanceNo)
+		}
+	}
+
+	return nil
+}
+
+func testAccNetworkInterfaceAttachmentVpcConfig(name, target string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+data "ncloud_server_image_numbers" "server_images" {
+	hypervisor_type = "KVM"
+	filter {
+		name = "name"
+		values = ["ubuntu-22.04-base"]
+	}
+}
+
+resource "ncloud_server" "active" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-a"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_server" "standby" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-s"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_network_interface" "test" {
+	name                  = "%[1]s"
+	subnet_no             = ncloud_subnet.test.id
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+
+	lifecycle {
+		ignore_changes = [server_instance_no]
+	}
+}
+
+resource "ncloud_network_interface_attachment" "test" {
+	network_interface_no = ncloud_network_interface.test.id
+	server_instance_no   = ncloud_server.%[2]s.id
+	order                = 1
+}
+`, name, target)
+}
@@ -96,6 +96,10 @@ func getVpcNetworkInterfaceListFiltered(d *schema.ResourceData, config *conn.Pro
 			instance["access_control_groups"] = StringPtrArrToStringArr(r.AccessControlGroupNoList)
 		}
 
+		if r.SecondaryIpList != nil {
+			instance["secondary_private_ips"] = StringPtrArrToStringArr(r.SecondaryIpList)
+		}
+
 		if r.InstanceType != nil {
 			instance["instance_type"] = *r.InstanceType.Code
 		}
@@ -79,7 +79,7 @@ func dataSourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interfa
 		return NotSupportVpc("data source `ncloud_port_forwarding_rule`")
 	}
 
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return err
 	}
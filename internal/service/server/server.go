@@ -24,6 +24,17 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
 
+const (
+	ServerInstanceStateRunning = "running"
+	ServerInstanceStateStopped = "stopped"
+)
+
+// Desired power state of the server instance by its status code
+var serverInstanceStateByStatus = map[string]string{
+	"RUN":   ServerInstanceStateRunning,
+	"NSTOP": ServerInstanceStateStopped,
+}
+
 func ResourceNcloudServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNcloudServerCreate,
@@ -95,6 +106,12 @@ func ResourceNcloudServer() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"instance_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{ServerInstanceStateRunning, ServerInstanceStateStopped}, false)),
+			},
 			// Deprecated
 			"internet_line_type": {
 				Type:             schema.TypeString,
@@ -287,6 +304,13 @@ func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] Server instance ID: %s", d.Id())
 
+	if d.Get("instance_state").(string) == ServerInstanceStateStopped {
+		log.Printf("[INFO] Stopping Instance %q for instance_state", d.Id())
+		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudServerRead(d, meta)
 }
 
@@ -376,6 +400,12 @@ func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("instance_state") {
+		if err := updateServerInstanceState(d, config); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudServerRead(d, meta)
 }
 
@@ -603,6 +633,10 @@ func updateServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 		return err
 	}
 
+	if d.Get("instance_state").(string) == ServerInstanceStateStopped {
+		return nil
+	}
+
 	log.Printf("[INFO] Start Instance %q for server_product_code change", d.Id())
 	if err := startThenWaitServerInstance(config, d.Id()); err != nil {
 		return err
@@ -611,6 +645,34 @@ func updateServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfi
 	return nil
 }
 
+func updateServerInstanceState(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	serverInstance, err := GetServerInstance(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if serverInstance == nil {
+		return fmt.Errorf("fail to get Server instance, %s doesn't exist", d.Id())
+	}
+
+	status := ncloud.StringValue(serverInstance.ServerInstanceStatus)
+
+	switch d.Get("instance_state").(string) {
+	case ServerInstanceStateRunning:
+		if status != "RUN" {
+			log.Printf("[INFO] Start Instance %q for instance_state change", d.Id())
+			return startThenWaitServerInstance(config, d.Id())
+		}
+	case ServerInstanceStateStopped:
+		if status != "NSTOP" {
+			log.Printf("[INFO] Stopping Instance %q for instance_state change", d.Id())
+			return stopThenWaitServerInstance(config, d.Id())
+		}
+	}
+
+	return nil
+}
+
 func changeServerInstanceSpec(d *schema.ResourceData, config *conn.ProviderConfig) error {
 	var err error
 	if config.SupportVPC {
@@ -866,6 +928,7 @@ func convertClassicServerInstance(r *server.ServerInstance) *ServerInstance {
 		PortForwardingExternalPort:     r.PortForwardingExternalPort,
 		PortForwardingInternalPort:     r.PortForwardingInternalPort,
 		ServerInstanceStatus:           common.GetCodePtrByCommonCode(r.ServerInstanceStatus),
+		InstanceState:                  getServerInstanceState(r.ServerInstanceStatus),
 		PlatformType:                   common.GetCodePtrByCommonCode(r.PlatformType),
 		ServerInstanceOperation:        common.GetCodePtrByCommonCode(r.ServerInstanceOperation),
 		Zone:                           r.Zone.ZoneCode,
@@ -921,6 +984,7 @@ func convertVcpServerInstance(r *vserver.ServerInstance) *ServerInstance {
 		MemorySize:                     r.MemorySize,
 		PublicIp:                       r.PublicIp,
 		ServerInstanceStatus:           common.GetCodePtrByCommonCode(r.ServerInstanceStatus),
+		InstanceState:                  getServerInstanceState(r.ServerInstanceStatus),
 		PlatformType:                   common.GetCodePtrByCommonCode(r.PlatformType),
 		ServerInstanceOperation:        common.GetCodePtrByCommonCode(r.ServerInstanceOperation),
 		Zone:                           r.ZoneCode,
@@ -1414,6 +1478,14 @@ func waitForAttachedBlockStorage(config *conn.ProviderConfig, no string) error {
 	return nil
 }
 
+// getServerInstanceState returns nil while the server is in transition (e.g. creating, stopping)
+func getServerInstanceState(status interface{}) *string {
+	if state, ok := serverInstanceStateByStatus[ncloud.StringValue(common.GetCodePtrByCommonCode(status))]; ok {
+		return ncloud.String(state)
+	}
+	return nil
+}
+
 func getServerZoneNo(config *conn.ProviderConfig, serverInstanceNo string) (string, error) {
 	instance, err := GetServerInstance(config, serverInstanceNo)
 	if err != nil || instance == nil || instance.ZoneNo == nil {
@@ -1448,6 +1520,7 @@ type ServerInstance struct {
 	PortForwardingExternalPort     *int32                `json:"port_forwarding_external_port,omitempty"`
 	PortForwardingInternalPort     *int32                `json:"port_forwarding_internal_port,omitempty"`
 	ServerInstanceStatus           *string               `json:"status,omitempty"`
+	InstanceState                  *string               `json:"instance_state,omitempty"`
 	PlatformType                   *string               `json:"platform_type,omitempty"`
 	ServerInstanceOperation        *string               `json:"operation,omitempty"`
 	Zone                           *string               `json:"zone,omitempty"`
@@ -61,7 +61,7 @@ func getServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*Serv
 }
 
 func getClassicServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
-	regionNo, err := conn.ParseRegionNoParameter(d)
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
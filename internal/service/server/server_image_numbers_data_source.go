@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -167,14 +166,14 @@ func (d *serverImageNumbersDataSource) Read(ctx context.Context, req datasource.
 		ServerImageName:    data.ServerImageName.ValueStringPointer(),
 		HypervisorCodeList: []*string{data.HypervisorType.ValueStringPointer()},
 	}
-	tflog.Info(ctx, "GetServerImageListRequest reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetServerImageListRequest", reqParams)
 
 	imageNoResp, err := d.config.Client.Vserver.V2Api.GetServerImageList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetServerImageListRequest response="+common.MarshalUncheckedString(imageNoResp))
+	common.LogResponseContext(ctx, "GetServerImageListRequest", imageNoResp)
 
 	if imageNoResp == nil || len(imageNoResp.ServerImageList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &serverSpecFunction{}
+
+// e.g. SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002
+var serverProductCodeRegexp = regexp.MustCompile(`^SVR\.VSVR\.([A-Z0-9]+)\.C(\d{3})\.M(\d{3})\.NET\.(HDD|SSD)\.B(\d{3})\.G(\d{3})$`)
+
+// e.g. s2-g2-s50, c4-g3
+var serverSpecCodeRegexp = regexp.MustCompile(`^([a-z]+)(\d+)-g(\d+)[a-z]*(?:-([sh])(\d+))?$`)
+
+// Memory size in GB per vCPU of the server spec types
+var serverSpecMemoryRatios = map[string]int64{
+	"c": 2,
+	"s": 4,
+	"m": 8,
+}
+
+var serverSpecAttributeTypes = map[string]attr.Type{
+	"type":                       types.StringType,
+	"cpu_count":                  types.Int64Type,
+	"memory_size_gb":             types.Int64Type,
+	"disk_type":                  types.StringType,
+	"base_block_storage_size_gb": types.Int64Type,
+	"generation_code":            types.StringType,
+}
+
+func NewServerSpecFunction() function.Function {
+	return &serverSpecFunction{}
+}
+
+type serverSpecFunction struct{}
+
+func (f *serverSpecFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "server_spec"
+}
+
+func (f *serverSpecFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parses a server spec code or server product code",
+		Description: "Parses a server spec code (e.g. `s2-g2-s50`) or server product code (e.g. `SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002`) into its type, cpu count, memory size, disk type, base block storage size and generation. Attributes which the code doesn't determine are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "code",
+				Description: "Server spec code or server product code",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: serverSpecAttributeTypes,
+		},
+	}
+}
+
+func (f *serverSpecFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var code string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &code))
+	if resp.Error != nil {
+		return
+	}
+
+	spec, err := parseServerSpec(code)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, spec))
+}
+
+type serverSpecModel struct {
+	Type                   types.String `tfsdk:"type"`
+	CpuCount               types.Int64  `tfsdk:"cpu_count"`
+	MemorySizeGb           types.Int64  `tfsdk:"memory_size_gb"`
+	DiskType               types.String `tfsdk:"disk_type"`
+	BaseBlockStorageSizeGb types.Int64  `tfsdk:"base_block_storage_size_gb"`
+	GenerationCode         types.String `tfsdk:"generation_code"`
+}
+
+func parseServerSpec(code string) (*serverSpecModel, error) {
+	if m := serverProductCodeRegexp.FindStringSubmatch(code); m != nil {
+		generation, _ := strconv.Atoi(m[6])
+		return &serverSpecModel{
+			Type:                   types.StringValue(m[1]),
+			CpuCount:               types.Int64Value(atoi64(m[2])),
+			MemorySizeGb:           types.Int64Value(atoi64(m[3])),
+			DiskType:               types.StringValue(m[4]),
+			BaseBlockStorageSizeGb: types.Int64Value(atoi64(m[5])),
+			GenerationCode:         types.StringValue(fmt.Sprintf("G%d", generation)),
+		}, nil
+	}
+
+	if m := serverSpecCodeRegexp.FindStringSubmatch(code); m != nil {
+		cpuCount := atoi64(m[2])
+		spec := &serverSpecModel{
+			Type:                   types.StringValue(m[1]),
+			CpuCount:               types.Int64Value(cpuCount),
+			MemorySizeGb:           types.Int64Null(),
+			DiskType:               types.StringNull(),
+			BaseBlockStorageSizeGb: types.Int64Null(),
+			GenerationCode:         types.StringValue("G" + m[3]),
+		}
+		if ratio, ok := serverSpecMemoryRatios[m[1]]; ok {
+			spec.MemorySizeGb = types.Int64Value(cpuCount * ratio)
+		}
+		if m[4] != "" {
+			spec.DiskType = types.StringValue(map[string]string{"s": "SSD", "h": "HDD"}[m[4]])
+			spec.BaseBlockStorageSizeGb = types.Int64Value(atoi64(m[5]))
+		}
+		return spec, nil
+	}
+
+	return nil, fmt.Errorf("%q is neither a server spec code nor a server product code", code)
+}
+
+func atoi64(s string) int64 {
+	v, _ := strconv.ParseInt(s, 10, 64)
+	return v
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseServerSpec(t *testing.T) {
+	cases := []struct {
+		code     string
+		expected serverSpecModel
+	}{
+		{
+			"SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002",
+			serverSpecModel{
+				Type:                   types.StringValue("STAND"),
+				CpuCount:               types.Int64Value(2),
+				MemorySizeGb:           types.Int64Value(8),
+				DiskType:               types.StringValue("HDD"),
+				BaseBlockStorageSizeGb: types.Int64Value(50),
+				GenerationCode:         types.StringValue("G2"),
+			},
+		},
+		{
+			"s2-g2-s50",
+			serverSpecModel{
+				Type:                   types.StringValue("s"),
+				CpuCount:               types.Int64Value(2),
+				MemorySizeGb:           types.Int64Value(8),
+				DiskType:               types.StringValue("SSD"),
+				BaseBlockStorageSizeGb: types.Int64Value(50),
+				GenerationCode:         types.StringValue("G2"),
+			},
+		},
+		{
+			"c4-g3",
+			serverSpecModel{
+				Type:                   types.StringValue("c"),
+				CpuCount:               types.Int64Value(4),
+				MemorySizeGb:           types.Int64Value(8),
+				DiskType:               types.StringNull(),
+				BaseBlockStorageSizeGb: types.Int64Null(),
+				GenerationCode:         types.StringValue("G3"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseServerSpec(tc.code)
+		if err != nil {
+			t.Fatalf("parseServerSpec(%s) returned error: %s", tc.code, err)
+		}
+		if *actual != tc.expected {
+			t.Fatalf("parseServerSpec(%s) expected %v but %v", tc.code, tc.expected, *actual)
+		}
+	}
+
+	if _, err := parseServerSpec("SW.VSVR.OS.LNX64.ROCKY.0810.B050"); err == nil {
+		t.Fatalf("parseServerSpec must fail for a server image product code")
+	}
+}
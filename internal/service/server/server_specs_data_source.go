@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
@@ -135,14 +134,14 @@ func (d *serverSpecsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	reqParams := &vserver.GetServerSpecListRequest{
 		RegionCode: &d.config.RegionCode,
 	}
-	tflog.Info(ctx, "GetServerSpecListRequest reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetServerSpecListRequest", reqParams)
 
 	specResp, err := d.config.Client.Vserver.V2Api.GetServerSpecList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetServerSpecListRequest response="+common.MarshalUncheckedString(specResp))
+	common.LogResponseContext(ctx, "GetServerSpecListRequest", specResp)
 
 	if specResp == nil || len(specResp.ServerSpecList) < 1 {
 		resp.Diagnostics.AddError("READING ERROR", "no result.")
@@ -287,6 +287,39 @@ func TestAccResourceNcloudServer_vpc_changeSpec(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudServer_vpc_instanceState(t *testing.T) {
+	var before serverservice.ServerInstance
+	var after serverservice.ServerInstance
+	testServerName := GetTestServerName()
+	resourceName := "ncloud_server.server"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigInstanceState(testServerName, "stopped"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "stopped"),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigInstanceState(testServerName, "running"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "running"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+			{
+				ResourceName:      "ncloud_server.server",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestConvertToMap(t *testing.T) {
 	i := &serverservice.ServerInstance{
 		ZoneNo:                     ncloud.String("KR-1"),
@@ -441,6 +474,45 @@ resource "ncloud_server" "server" {
 `, testServerName, specCode)
 }
 
+func testAccServerVpcConfigInstanceState(testServerName, instanceState string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+data "ncloud_server_image_numbers" "server_images" {
+    filter {
+        name = "name"
+        values = ["ubuntu-22.04-base"]
+    }
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	instance_state = "%[2]s"
+}
+`, testServerName, instanceState)
+}
+
 func testAccServerVpcConfig(testServerName, productCode string) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
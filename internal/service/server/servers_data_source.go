@@ -2,13 +2,22 @@ package server
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/zone"
 )
 
+const serverListPageSize = 100
+
 func DataSourceNcloudServers() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceNcloudServersRead,
@@ -19,15 +28,77 @@ func DataSourceNcloudServers() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_no": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"placement_group_no": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"tag_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"filter": DataSourceFiltersSchema(),
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceNcloudServersItemSchema(),
+			},
 		},
 	}
 }
 
+func dataSourceNcloudServersItemSchema() *schema.Resource {
+	itemSchema := GetDataSourceItemSchema(ResourceNcloudServer())
+
+	// tag_list is optional only in ncloud_server, which is not carried over to the data source item
+	itemSchema.Schema["tag_list"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tag_key": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag_value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return itemSchema
+}
+
 func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	instances, err := getServerList(d, config)
+	instances, err := getServerListFiltered(d, config)
 	if err != nil {
 		return err
 	}
@@ -37,7 +108,16 @@ func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if values, ok := d.GetOk("ids"); ok {
-		return readServersIDs(d, values.(*schema.Set).List(), instances)
+		instances, err = filterServersByIds(values.(*schema.Set).List(), instances)
+		if err != nil {
+			return err
+		}
+	}
+
+	if config.SupportVPC {
+		if err := buildNetworkInterfaceListAll(config, instances); err != nil {
+			return err
+		}
 	}
 
 	resources := ConvertToArrayMap(instances)
@@ -49,36 +129,275 @@ func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("no results with filter. there is no available server resource")
 	}
 
+	itemSchema := dataSourceNcloudServersItemSchema().Schema
 	var ids []string
+	var servers []map[string]interface{}
 	for _, r := range resources {
-		for k, v := range r {
-			if k == "instance_no" {
-				ids = append(ids, v.(string))
-			}
-		}
+		ids = append(ids, r["instance_no"].(string))
+		servers = append(servers, flattenServerItem(r, itemSchema))
 	}
 
 	d.SetId(DataResourceIdHash(ids))
 	d.Set("ids", ids)
+	if err := d.Set("servers", servers); err != nil {
+		return fmt.Errorf("error setting servers: %s", err)
+	}
+
 	return nil
 }
 
-func readServersIDs(d *schema.ResourceData, values []interface{}, serverInstances []*ServerInstance) error {
-	var ids []string
+func filterServersByIds(values []interface{}, serverInstances []*ServerInstance) ([]*ServerInstance, error) {
+	var list []*ServerInstance
 	for _, id := range values {
 		for _, s := range serverInstances {
 			if *s.ServerInstanceNo == id.(string) {
-				ids = append(ids, id.(string))
+				list = append(list, s)
 				break
 			}
 		}
 	}
 
-	if len(values) != len(ids) {
-		return fmt.Errorf("invalid server id specified")
+	if len(values) != len(list) {
+		return nil, fmt.Errorf("invalid server id specified")
+	}
+
+	return list, nil
+}
+
+// flattenServerItem keeps only the attributes of the server schema and converts the tag list of classic
+func flattenServerItem(r map[string]interface{}, itemSchema map[string]*schema.Schema) map[string]interface{} {
+	m := map[string]interface{}{
+		"id": r["instance_no"],
+	}
+
+	for k, v := range r {
+		if _, ok := itemSchema[k]; ok && v != nil {
+			m[k] = v
+		}
+	}
+
+	if tags, ok := r["tag_list"].([]interface{}); ok {
+		var tagList []map[string]interface{}
+		for _, t := range tags {
+			tag := t.(map[string]interface{})
+			tagList = append(tagList, map[string]interface{}{
+				"tag_key":   tag["tagKey"],
+				"tag_value": tag["tagValue"],
+			})
+		}
+		m["tag_list"] = tagList
+	}
+
+	return m
+}
+
+func getServerListFiltered(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
+	var list []*ServerInstance
+	var err error
+
+	if config.SupportVPC {
+		list, err = getVpcServerListAll(d, config)
+	} else {
+		list, err = getClassicServerListAll(d, config)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	var filtered []*ServerInstance
+	for _, r := range list {
+		if v, ok := d.GetOk("subnet_no"); ok && ncloud.StringValue(r.SubnetNo) != v.(string) {
+			continue
+		}
+
+		// The VPC API does not filter by zone
+		if v, ok := d.GetOk("zone"); ok && ncloud.StringValue(r.Zone) != v.(string) {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(ncloud.StringValue(r.ServerName)) {
+			continue
+		}
+
+		filtered = append(filtered, r)
+	}
+
+	return filtered, nil
+}
+
+func getClassicServerListAll(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
+	if _, ok := d.GetOk("vpc_no"); ok {
+		return nil, NotSupportClassic("`vpc_no` of data source `ncloud_servers`")
+	}
+
+	if _, ok := d.GetOk("placement_group_no"); ok {
+		return nil, NotSupportClassic("`placement_group_no` of data source `ncloud_servers`")
+	}
+
+	regionNo, err := conn.ParseRegionNoParameter(config, d)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneNo, err := zone.ParseZoneNoParameter(config, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*ServerInstance
+
+	for pageNo := int32(1); ; pageNo++ {
+		reqParams := &server.GetServerInstanceListRequest{
+			RegionNo:                 regionNo,
+			ZoneNo:                   zoneNo,
+			ServerInstanceNoList:     expandServerInstanceNoList(d),
+			ServerInstanceStatusCode: StringPtrOrNil(d.GetOk("status")),
+			PageNo:                   ncloud.Int32(pageNo),
+			PageSize:                 ncloud.Int32(serverListPageSize),
+		}
+
+		if v, ok := d.GetOk("tag_key"); ok {
+			reqParams.TagKeyList = []*string{ncloud.String(v.(string))}
+		}
+
+		if v, ok := d.GetOk("tag_value"); ok {
+			reqParams.TagValueList = []*string{ncloud.String(v.(string))}
+		}
+
+		LogCommonRequest("getClassicServerListAll", reqParams)
+		resp, err := config.Client.Server.V2Api.GetServerInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse("getClassicServerListAll", err, reqParams)
+			return nil, err
+		}
+		LogResponse("getClassicServerListAll", resp)
+
+		for _, r := range resp.ServerInstanceList {
+			list = append(list, convertClassicServerInstance(r))
+		}
+
+		if len(resp.ServerInstanceList) == 0 || len(list) >= int(ncloud.Int32Value(resp.TotalRows)) {
+			break
+		}
+	}
+
+	return list, nil
+}
+
+func getVpcServerListAll(d *schema.ResourceData, config *conn.ProviderConfig) ([]*ServerInstance, error) {
+	if _, ok := d.GetOk("tag_key"); ok {
+		return nil, fmt.Errorf("`tag_key` of data source `ncloud_servers` only supports Classic environment")
+	}
+
+	if _, ok := d.GetOk("tag_value"); ok {
+		return nil, fmt.Errorf("`tag_value` of data source `ncloud_servers` only supports Classic environment")
+	}
+
+	var list []*ServerInstance
+
+	for pageNo := int32(1); ; pageNo++ {
+		reqParams := &vserver.GetServerInstanceListRequest{
+			RegionCode:               &config.RegionCode,
+			ServerInstanceNoList:     expandServerInstanceNoList(d),
+			VpcNo:                    StringPtrOrNil(d.GetOk("vpc_no")),
+			ServerInstanceStatusCode: StringPtrOrNil(d.GetOk("status")),
+			PageNo:                   ncloud.Int32(pageNo),
+			PageSize:                 ncloud.Int32(serverListPageSize),
+		}
+
+		if v, ok := d.GetOk("placement_group_no"); ok {
+			reqParams.PlacementGroupNoList = []*string{ncloud.String(v.(string))}
+		}
+
+		LogCommonRequest("getVpcServerListAll", reqParams)
+		resp, err := config.Client.Vserver.V2Api.GetServerInstanceList(reqParams)
+		if err != nil {
+			LogErrorResponse("getVpcServerListAll", err, reqParams)
+			return nil, err
+		}
+		LogResponse("getVpcServerListAll", resp)
+
+		for _, r := range resp.ServerInstanceList {
+			list = append(list, convertVcpServerInstance(r))
+		}
+
+		if len(resp.ServerInstanceList) == 0 || len(list) >= int(ncloud.Int32Value(resp.TotalRows)) {
+			break
+		}
+	}
+
+	return list, nil
+}
+
+func expandServerInstanceNoList(d *schema.ResourceData) []*string {
+	if v, ok := d.GetOk("ids"); ok {
+		return ExpandStringSet(v.(*schema.Set))
+	}
+	return nil
+}
+
+// buildNetworkInterfaceListAll fills the network interfaces of the servers by listing them in batches of a page,
+// instead of getting each of them like buildNetworkInterfaceList
+func buildNetworkInterfaceListAll(config *conn.ProviderConfig, instances []*ServerInstance) error {
+	var networkInterfaceNoList []*string
+	for _, instance := range instances {
+		for _, ni := range instance.NetworkInterfaceList {
+			networkInterfaceNoList = append(networkInterfaceNoList, ni.NetworkInterfaceNo)
+		}
+	}
+
+	networkInterfaces := map[string]*vserver.NetworkInterface{}
+
+	for start := 0; start < len(networkInterfaceNoList); start += serverListPageSize {
+		end := start + serverListPageSize
+		if end > len(networkInterfaceNoList) {
+			end = len(networkInterfaceNoList)
+		}
+
+		reqParams := &vserver.GetNetworkInterfaceListRequest{
+			RegionCode:             &config.RegionCode,
+			NetworkInterfaceNoList: networkInterfaceNoList[start:end],
+			PageNo:                 ncloud.Int32(1),
+			PageSize:               ncloud.Int32(serverListPageSize),
+		}
+
+		LogCommonRequest("buildNetworkInterfaceListAll", reqParams)
+		resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(reqParams)
+		if err != nil {
+			LogErrorResponse("buildNetworkInterfaceListAll", err, reqParams)
+			return err
+		}
+		LogResponse("buildNetworkInterfaceListAll", resp)
+
+		for _, r := range resp.NetworkInterfaceList {
+			networkInterfaces[ncloud.StringValue(r.NetworkInterfaceNo)] = r
+		}
+	}
+
+	re := regexp.MustCompile("[0-9]+")
+	for _, instance := range instances {
+		for _, ni := range instance.NetworkInterfaceList {
+			networkInterface, ok := networkInterfaces[ncloud.StringValue(ni.NetworkInterfaceNo)]
+			if !ok {
+				continue
+			}
+
+			order, err := strconv.Atoi(re.FindString(ncloud.StringValue(networkInterface.DeviceName)))
+			if err != nil {
+				return fmt.Errorf("error parsing network interface device name: %s", ncloud.StringValue(networkInterface.DeviceName))
+			}
+
+			ni.PrivateIp = networkInterface.Ip
+			ni.SubnetNo = networkInterface.SubnetNo
+			ni.Order = ncloud.Int32(int32(order))
+		}
 	}
 
-	d.SetId(DataResourceIdHash(ids))
-	d.Set("ids", ids)
 	return nil
 }
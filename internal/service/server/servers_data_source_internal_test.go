@@ -0,0 +1,161 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/mockapi"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestGetVpcServerListAll_paging(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client, err := (&conn.Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: server.Endpoints(),
+	}).Client("public")
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR", Client: client}
+
+	vpcs, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{Ipv4CidrBlock: ncloud.String("10.0.0.0/16")})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	vpcNo := vpcs.VpcList[0].VpcNo
+	acls, _ := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: vpcNo})
+	subnets, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		VpcNo:          vpcNo,
+		ZoneCode:       ncloud.String("KR-2"),
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		NetworkAclNo:   acls.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PRIVATE"),
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	// More servers than a page, which the mock returns with 1-based page numbers like the API
+	count := serverListPageSize + 50
+	if _, err := client.Vserver.V2Api.CreateServerInstances(&vserver.CreateServerInstancesRequest{
+		VpcNo:             vpcNo,
+		SubnetNo:          subnets.SubnetList[0].SubnetNo,
+		ServerName:        ncloud.String("tf-server"),
+		ServerCreateCount: ncloud.Int32(int32(count)),
+		NetworkInterfaceList: []*vserver.NetworkInterfaceParameter{
+			{NetworkInterfaceOrder: ncloud.Int32(0)},
+		},
+	}); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceNcloudServers().Schema, map[string]interface{}{
+		"vpc_no": ncloud.StringValue(vpcNo),
+	})
+
+	instances, err := getVpcServerListAll(d, config)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if len(instances) != count {
+		t.Fatalf("Servers expected %d but %d", count, len(instances))
+	}
+
+	seen := map[string]bool{}
+	for _, instance := range instances {
+		if seen[ncloud.StringValue(instance.ServerInstanceNo)] {
+			t.Fatalf("Server %s listed twice", ncloud.StringValue(instance.ServerInstanceNo))
+		}
+		seen[ncloud.StringValue(instance.ServerInstanceNo)] = true
+	}
+
+	// Only the network interfaces of the listed servers are requested, a page at a time
+	instances = instances[:serverListPageSize+1]
+	if err := buildNetworkInterfaceListAll(config, instances); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	for _, instance := range instances {
+		ni := instance.NetworkInterfaceList[0]
+		if ncloud.Int32Value(ni.Order) != 0 || ncloud.StringValue(ni.SubnetNo) != ncloud.StringValue(subnets.SubnetList[0].SubnetNo) {
+			t.Fatalf("Network interface of server %s expected eth0 in the subnet but %v", ncloud.StringValue(instance.ServerInstanceNo), ni)
+		}
+	}
+	if calls := server.Calls("getNetworkInterfaceList"); calls != 2 {
+		t.Fatalf("getNetworkInterfaceList expected to be called 2 times but %d", calls)
+	}
+}
+
+func TestGetVpcServerListAll_ids(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client, err := (&conn.Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: server.Endpoints(),
+	}).Client("public")
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR", Client: client}
+
+	vpcs, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{Ipv4CidrBlock: ncloud.String("10.0.0.0/16")})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	vpcNo := vpcs.VpcList[0].VpcNo
+	acls, _ := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: vpcNo})
+	subnets, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+		VpcNo:          vpcNo,
+		ZoneCode:       ncloud.String("KR-2"),
+		Subnet:         ncloud.String("10.0.1.0/24"),
+		NetworkAclNo:   acls.NetworkAclList[0].NetworkAclNo,
+		SubnetTypeCode: ncloud.String("PRIVATE"),
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	resp, err := client.Vserver.V2Api.CreateServerInstances(&vserver.CreateServerInstancesRequest{
+		VpcNo:             vpcNo,
+		SubnetNo:          subnets.SubnetList[0].SubnetNo,
+		ServerName:        ncloud.String("tf-server"),
+		ServerCreateCount: ncloud.Int32(int32(serverListPageSize + 50)),
+		NetworkInterfaceList: []*vserver.NetworkInterfaceParameter{
+			{NetworkInterfaceOrder: ncloud.Int32(0)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	ids := []interface{}{
+		ncloud.StringValue(resp.ServerInstanceList[0].ServerInstanceNo),
+		ncloud.StringValue(resp.ServerInstanceList[serverListPageSize+10].ServerInstanceNo),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceNcloudServers().Schema, map[string]interface{}{
+		"ids": ids,
+	})
+
+	// The ids are filtered by the API, so a single page is requested
+	instances, err := getVpcServerListAll(d, config)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if len(instances) != len(ids) {
+		t.Fatalf("Servers expected %d but %d", len(ids), len(instances))
+	}
+	if calls := server.Calls("getServerInstanceList"); calls != 1 {
+		t.Fatalf("getServerInstanceList expected to be called 1 time but %d", calls)
+	}
+}
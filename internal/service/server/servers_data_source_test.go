@@ -13,6 +13,7 @@ import (
 const (
 	dataName   = "data.ncloud_servers.by_id"
 	dataName2  = "data.ncloud_servers.by_filter"
+	dataName3  = "data.ncloud_servers.by_subnet"
 	serverName = "ncloud_server.test"
 )
 
@@ -33,6 +34,12 @@ func TestAccDataSourceNcloudServers_vpc_basic(t *testing.T) {
 					TestAccCheckDataSourceID(dataName2),
 					resource.TestCheckResourceAttr(dataName2, "ids.#", "1"),
 					resource.TestCheckResourceAttrPair(dataName2, "ids.0", serverName, "id"),
+					resource.TestCheckResourceAttr(dataName3, "servers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataName3, "servers.0.id", serverName, "id"),
+					resource.TestCheckResourceAttrPair(dataName3, "servers.0.private_ip", serverName, "private_ip"),
+					resource.TestCheckResourceAttrPair(dataName3, "servers.0.server_spec_code", serverName, "server_spec_code"),
+					resource.TestCheckResourceAttrPair(dataName3, "servers.0.network_interface.0.network_interface_no", serverName, "network_interface.0.network_interface_no"),
+					resource.TestCheckResourceAttr(dataName3, "servers.0.network_interface.0.order", "0"),
 				),
 			},
 		},
@@ -116,6 +123,16 @@ data "ncloud_servers" "by_filter" {
 		values = [ncloud_server.test.id]
 	}
 }
+
+data "ncloud_servers" "by_subnet" {
+	vpc_no     = ncloud_vpc.test.id
+	subnet_no  = ncloud_subnet.test.id
+	zone       = "KR-2"
+	status     = "RUN"
+	name_regex = "^%[1]s$"
+
+	depends_on = [ncloud_server.test, ncloud_server.test2]
+}
 `, testServerName, testServerName2)
 }
 
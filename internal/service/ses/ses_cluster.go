@@ -314,15 +314,15 @@ func resourceNcloudSESClusterCreate(ctx context.Context, d *schema.ResourceData,
 		MasterNodeCount:           masterNodeCount,
 		LoginKeyName:              StringPtrOrNil(d.GetOk("login_key_name")),
 	}
-	LogCommonRequest("resourceNcloudSESClusterCreate", reqParams)
+	LogCommonRequestContext(ctx, "resourceNcloudSESClusterCreate", reqParams)
 	resp, _, err := config.Client.Vses.V2Api.CreateClusterUsingPOST(ctx, *reqParams)
 	if err != nil {
-		LogErrorResponse("resourceNcloudSESClusterCreate", err, reqParams)
+		LogErrorResponseContext(ctx, "resourceNcloudSESClusterCreate", err, reqParams)
 		return diag.FromErr(err)
 	}
 	id := strconv.Itoa(int(ncloud.Int32Value(resp.Result.ServiceGroupInstanceNo)))
 
-	LogResponse("resourceNcloudSESClusterCreate", resp)
+	LogResponseContext(ctx, "resourceNcloudSESClusterCreate", resp)
 	if err := waitForSESClusterActive(ctx, d, config, id); err != nil {
 		return diag.FromErr(err)
 	}
@@ -461,7 +461,7 @@ func checkSearchEngineChanged(ctx context.Context, d *schema.ResourceData, confi
 		oldSearchEngineMap := o.([]interface{})[0].(map[string]interface{})
 		newSearchEngineMap := n.([]interface{})[0].(map[string]interface{})
 		if oldSearchEngineMap["user_password"] != newSearchEngineMap["user_password"] {
-			LogCommonRequest("resourceNcloudSESClusterUpdate", d.Id())
+			LogCommonRequestContext(ctx, "resourceNcloudSESClusterUpdate", d.Id())
 			if err := waitForSESClusterActive(ctx, d, config, d.Id()); err != nil {
 				return fmt.Errorf("error waiting for SES Cluster (%s) to become activating: %s", d.Id(), err)
 			}
@@ -471,7 +471,7 @@ func checkSearchEngineChanged(ctx context.Context, d *schema.ResourceData, confi
 			}
 
 			if _, _, err := config.Client.Vses.V2Api.ResetSearchEngineUserPasswordUsingPOST(ctx, d.Id(), reqParams); err != nil {
-				LogErrorResponse("resourceNcloudSESClusterResetSearchEngineUserPassword", err, d.Id())
+				LogErrorResponseContext(ctx, "resourceNcloudSESClusterResetSearchEngineUserPassword", err, d.Id())
 				return fmt.Errorf("error Reset Search Engine User Password with Cluster (%s) : %s", d.Id(), err)
 			}
 
@@ -494,7 +494,7 @@ func checkDataNodeChanged(ctx context.Context, d *schema.ResourceData, config *c
 		newDataNodeCount := *Int32PtrOrNil(newDataNodeMap["count"], true)
 
 		if oldDataNodeCount < newDataNodeCount {
-			LogCommonRequest("resourceNcloudSESClusterUpdate", d.Id())
+			LogCommonRequestContext(ctx, "resourceNcloudSESClusterUpdate", d.Id())
 			if err := waitForSESClusterActive(ctx, d, config, d.Id()); err != nil {
 				return fmt.Errorf("error waiting for SES Cluster (%s) to become activating: %s", d.Id(), err)
 			}
@@ -504,7 +504,7 @@ func checkDataNodeChanged(ctx context.Context, d *schema.ResourceData, config *c
 			}
 
 			if _, _, err := config.Client.Vses.V2Api.AddNodesInClusterUsingPOST(ctx, d.Id(), reqParams); err != nil {
-				LogErrorResponse("resourceNcloudSESClusterAddNodes", err, d.Id())
+				LogErrorResponseContext(ctx, "resourceNcloudSESClusterAddNodes", err, d.Id())
 				return fmt.Errorf("error Add Nodes to SES Cluster (%s) : %s", d.Id(), err)
 			}
 
@@ -512,7 +512,7 @@ func checkDataNodeChanged(ctx context.Context, d *schema.ResourceData, config *c
 				return fmt.Errorf("error waiting for SES Cluster (%s) to become activating: %s", d.Id(), err)
 			}
 		} else if oldDataNodeCount > newDataNodeCount {
-			LogErrorResponse("resourceNcloudSESClusterAddNodes", nil, d.Id())
+			LogErrorResponseContext(ctx, "resourceNcloudSESClusterAddNodes", nil, d.Id())
 			return fmt.Errorf("data node count cannot be decreased")
 		}
 	}
@@ -532,7 +532,7 @@ func checkNodeProductCodeChanged(ctx context.Context, d *schema.ResourceData, co
 		}
 
 		if _, _, err := config.Client.Vses.V2Api.ChangeSpecNodeUsingPOST1(ctx, d.Id(), reqParams); err != nil {
-			LogErrorResponse("resourceNcloudSESClusterChangeSpec", nil, d.Id())
+			LogErrorResponseContext(ctx, "resourceNcloudSESClusterChangeSpec", nil, d.Id())
 			return fmt.Errorf("error Change Node Product Code (%s) : %s", d.Id(), err)
 		}
 
@@ -569,9 +569,9 @@ func resourceNcloudSESClusterDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	LogCommonRequest("resourceNcloudSESClusterDelete", d.Id())
+	LogCommonRequestContext(ctx, "resourceNcloudSESClusterDelete", d.Id())
 	if _, _, err := config.Client.Vses.V2Api.DeleteClusterUsingDELETE(ctx, d.Id()); err != nil {
-		LogErrorResponse("resourceNcloudSESClusterDelete", err, d.Id())
+		LogErrorResponseContext(ctx, "resourceNcloudSESClusterDelete", err, d.Id())
 		return diag.FromErr(err)
 	}
 
@@ -637,7 +637,7 @@ func GetSESCluster(ctx context.Context, config *conn.ProviderConfig, id string)
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("GetSESCluster", resp)
+	LogResponseContext(ctx, "GetSESCluster", resp)
 
 	return resp.Result, nil
 }
@@ -648,7 +648,7 @@ func getSESClusters(ctx context.Context, config *conn.ProviderConfig) (*vses2.Ge
 	if err != nil {
 		return nil, err
 	}
-	LogResponse("GetSESClusterList", resp)
+	LogResponseContext(ctx, "GetSESClusterList", resp)
 
 	return resp.Result, nil
 }
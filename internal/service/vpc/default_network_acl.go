@@ -0,0 +1,154 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func ResourceNcloudDefaultNetworkACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudDefaultNetworkACLCreate,
+		Read:   resourceNcloudDefaultNetworkACLRead,
+		Update: resourceNcloudDefaultNetworkACLUpdate,
+		Delete: resourceNcloudDefaultNetworkACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(conn.DefaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"inbound":  networkACLRuleSchema(),
+			"outbound": networkACLRuleSchema(),
+			"network_acl_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudDefaultNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if !config.SupportVPC {
+		return NotSupportClassic("resource `ncloud_default_network_acl`")
+	}
+
+	networkACLNo, err := getDefaultNetworkACL(config, d.Get("vpc_no").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(networkACLNo)
+	log.Printf("[INFO] Default Network ACL ID: %s", d.Id())
+
+	// Reset the rules of the default Network ACL to the declared rules
+	rules, err := GetNetworkACLRuleList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	iSet, oSet := flattenNetworkACLRules(rules)
+
+	if err := replaceNetworkACLRule(d, config, "inbound", iSet, d.Get("inbound").(*schema.Set)); err != nil {
+		return err
+	}
+
+	if err := replaceNetworkACLRule(d, config, "outbound", oSet, d.Get("outbound").(*schema.Set)); err != nil {
+		return err
+	}
+
+	return resourceNcloudDefaultNetworkACLRead(d, meta)
+}
+
+func resourceNcloudDefaultNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	instance, err := GetNetworkACLInstance(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if !ncloud.BoolValue(instance.IsDefault) {
+		return fmt.Errorf("Network ACL (%s) is not the default Network ACL of VPC (%s)", d.Id(), ncloud.StringValue(instance.VpcNo))
+	}
+
+	rules, err := GetNetworkACLRuleList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("vpc_no", instance.VpcNo)
+	d.Set("network_acl_no", instance.NetworkAclNo)
+	d.Set("name", instance.NetworkAclName)
+	d.Set("description", instance.NetworkAclDescription)
+	d.Set("is_default", instance.IsDefault)
+
+	iSet, oSet := flattenNetworkACLRules(rules)
+
+	if err := d.Set("inbound", iSet.List()); err != nil {
+		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("outbound", oSet.List()); err != nil {
+		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNcloudDefaultNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if d.HasChange("inbound") {
+		if err := updateNetworkACLRule(d, config, "inbound"); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("outbound") {
+		if err := updateNetworkACLRule(d, config, "outbound"); err != nil {
+			return err
+		}
+	}
+
+	return resourceNcloudDefaultNetworkACLRead(d, meta)
+}
+
+func resourceNcloudDefaultNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+	// The default Network ACL is deleted with the VPC. Leave it and its rules in place.
+	log.Printf("[WARN] Default Network ACL (%s) is not deleted, only removed from state", d.Id())
+
+	return nil
+}
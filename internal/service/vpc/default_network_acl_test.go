@@ -0,0 +1,83 @@
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudDefaultNetworkACL_basic(t *testing.T) {
+	var networkACLRule []*vpc.NetworkAclRule
+
+	resourceName := "ncloud_default_network_acl.default"
+	name := fmt.Sprintf("test-default-nacl-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudDefaultNetworkACLConfig(name, "22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLRuleExists(resourceName, &networkACLRule),
+					resource.TestCheckResourceAttrPair(resourceName, "network_acl_no", "ncloud_vpc.vpc", "default_network_acl_no"),
+					resource.TestMatchResourceAttr(resourceName, "vpc_no", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "true"),
+					resource.TestCheckResourceAttr(resourceName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outbound.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudDefaultNetworkACLConfig(name, "2222"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLRuleExists(resourceName, &networkACLRule),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "inbound.*", map[string]string{
+						"port_range": "2222",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "inbound.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudDefaultNetworkACLConfig(name, port string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_default_network_acl" "default" {
+	vpc_no = ncloud_vpc.vpc.id
+
+	inbound {
+		priority    = 100
+		protocol    = "TCP"
+		rule_action = "ALLOW"
+		ip_block    = "0.0.0.0/0"
+		port_range  = "%[2]s"
+	}
+
+	outbound {
+		priority    = 100
+		protocol    = "TCP"
+		rule_action = "ALLOW"
+		ip_block    = "0.0.0.0/0"
+		port_range  = "1-65535"
+	}
+}
+`, name, port)
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
 
@@ -53,6 +52,7 @@ func (n *natGatewayResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: verify.InstanceNameValidator(),
@@ -80,6 +80,7 @@ func (n *natGatewayResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -87,14 +88,17 @@ func (n *natGatewayResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			// The API has no operation to change the public IP of a NAT Gateway
 			"public_ip_no": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"nat_gateway_no": schema.StringAttribute{
@@ -177,14 +181,18 @@ func (n *natGatewayResource) Create(ctx context.Context, req resource.CreateRequ
 		reqParams.PrivateIp = plan.PrivateIp.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateNatGateway reqParams="+common.MarshalUncheckedString(reqParams))
+	if !plan.PublicIpNo.IsNull() && !plan.PublicIpNo.IsUnknown() {
+		reqParams.PublicIpInstanceNo = plan.PublicIpNo.ValueStringPointer()
+	}
+
+	common.LogCommonRequestContext(ctx, "CreateNatGateway", reqParams)
 
 	response, err := n.config.Client.Vpc.V2Api.CreateNatGatewayInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "CreateNatGateway response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "CreateNatGateway", response)
 
 	natGatewayInstance := response.NatGatewayInstanceList[0]
 	plan.ID = types.StringPointerValue(natGatewayInstance.NatGatewayInstanceNo)
@@ -241,16 +249,16 @@ func (n *natGatewayResource) Update(ctx context.Context, req resource.UpdateRequ
 		reqParams := &vpc.SetNatGatewayDescriptionRequest{
 			RegionCode:            &n.config.RegionCode,
 			NatGatewayInstanceNo:  state.NatGatewayNo.ValueStringPointer(),
-			NatGatewayDescription: plan.Description.ValueStringPointer(),
+			NatGatewayDescription: ncloud.String(plan.Description.ValueString()),
 		}
-		tflog.Info(ctx, "SetNatGatewayDescription reqParams="+common.MarshalUncheckedString(reqParams))
+		common.LogCommonRequestContext(ctx, "SetNatGatewayDescription", reqParams)
 
 		response, err := n.config.Client.Vpc.V2Api.SetNatGatewayDescription(reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
 			return
 		}
-		tflog.Info(ctx, "SetNatGatewayDescription response="+common.MarshalUncheckedString(response))
+		common.LogResponseContext(ctx, "SetNatGatewayDescription", response)
 
 		output, err := GetNatGatewayInstance(ctx, n.config, state.ID.ValueString())
 		if err != nil {
@@ -258,6 +266,11 @@ func (n *natGatewayResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
+		if output == nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", fmt.Sprintf("no matching NAT Gateway: %s", state.ID.ValueString()))
+			return
+		}
+
 		state.refreshFromOutput(output)
 	}
 
@@ -276,14 +289,14 @@ func (n *natGatewayResource) Delete(ctx context.Context, req resource.DeleteRequ
 		RegionCode:           &n.config.RegionCode,
 		NatGatewayInstanceNo: state.NatGatewayNo.ValueStringPointer(),
 	}
-	tflog.Info(ctx, "DeleteNatGateway reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "DeleteNatGateway", reqParams)
 
 	response, err := n.config.Client.Vpc.V2Api.DeleteNatGatewayInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "DeleteNatGateway response="+common.MarshalUncheckedString(response))
+	common.LogResponseContext(ctx, "DeleteNatGateway", response)
 
 	if err := WaitForNcloudNatGatewayDeletion(ctx, n.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
@@ -337,14 +350,14 @@ func GetNatGatewayInstance(ctx context.Context, config *conn.ProviderConfig, id
 		RegionCode:           &config.RegionCode,
 		NatGatewayInstanceNo: ncloud.String(id),
 	}
-	tflog.Info(ctx, "GetNatGatewayInstanceDetail reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetNatGatewayInstanceDetail", reqParams)
 
 	resp, err := config.Client.Vpc.V2Api.GetNatGatewayInstanceDetail(reqParams)
 	if err != nil {
-		common.LogErrorResponse("GetNatGatewayInstanceDetail", err, reqParams)
+		common.LogErrorResponseContext(ctx, "GetNatGatewayInstanceDetail", err, reqParams)
 		return nil, err
 	}
-	tflog.Info(ctx, "GetNatGatewayInstanceDetail response="+common.MarshalUncheckedString(resp))
+	common.LogResponseContext(ctx, "GetNatGatewayInstanceDetail", resp)
 
 	if resp == nil || len(resp.NatGatewayInstanceList) < 1 {
 		return nil, nil
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -130,14 +129,14 @@ func (n *natGatewayDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if !data.VpcName.IsNull() && !data.VpcName.IsUnknown() {
 		reqParams.VpcName = data.VpcName.ValueStringPointer()
 	}
-	tflog.Info(ctx, "GetNatGatewayList reqParams="+common.MarshalUncheckedString(reqParams))
+	common.LogCommonRequestContext(ctx, "GetNatGatewayList", reqParams)
 
 	natGatewayResp, err := n.config.Client.Vpc.V2Api.GetNatGatewayInstanceList(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("READING ERROR", err.Error())
 		return
 	}
-	tflog.Info(ctx, "GetNatGatewayList response="+common.MarshalUncheckedString(natGatewayResp))
+	common.LogResponseContext(ctx, "GetNatGatewayList", natGatewayResp)
 
 	natGatewayList, diags := flattenNatGateways(natGatewayResp.NatGatewayInstanceList)
 	resp.Diagnostics.Append(diags...)
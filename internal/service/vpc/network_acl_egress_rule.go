@@ -0,0 +1,9 @@
+package vpc
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceNcloudNetworkACLEgressRule() *schema.Resource {
+	return resourceNcloudNetworkACLSingleRule("outbound")
+}
@@ -0,0 +1,278 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+func ResourceNcloudNetworkACLIngressRule() *schema.Resource {
+	return resourceNcloudNetworkACLSingleRule("inbound")
+}
+
+// resourceNcloudNetworkACLSingleRule manages exactly one rule of ruleType ("inbound" or "outbound").
+// A rule is identified by its priority, which is unique per rule type in a Network ACL.
+// ID format: {network_acl_no}:{priority}
+func resourceNcloudNetworkACLSingleRule(ruleType string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceNcloudNetworkACLSingleRuleCreate(d, meta, ruleType)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceNcloudNetworkACLSingleRuleRead(d, meta, ruleType)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceNcloudNetworkACLSingleRuleDelete(d, meta, ruleType)
+		},
+		Importer: &schema.ResourceImporter{
+			State: resourceNcloudNetworkACLSingleRuleImportState,
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return resourceNcloudNetworkACLSingleRuleCustomizeDiff(ctx, diff, meta, ruleType)
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"network_acl_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 199)),
+			},
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"TCP", "UDP", "ICMP"}, false)),
+			},
+			"rule_action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALLOW", "DROP"}, false)),
+			},
+			"ip_block": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
+				ExactlyOneOf:     []string{"ip_block", "deny_allow_group_no"},
+			},
+			"deny_allow_group_no": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ip_block", "deny_allow_group_no"},
+			},
+			"port_range": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
+				Default:          "",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+				Default:          "",
+			},
+		},
+	}
+}
+
+func resourceNcloudNetworkACLSingleRuleCreate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if !config.SupportVPC {
+		return NotSupportClassic(fmt.Sprintf("resource `ncloud_network_acl_%s_rule`", networkACLSingleRuleName(ruleType)))
+	}
+
+	networkACLNo := d.Get("network_acl_no").(string)
+	priority := d.Get("priority").(int)
+
+	// Check again at apply time, rules created in the same plan are not known at plan time
+	if err := checkNetworkACLSingleRulePriority(config, networkACLNo, ruleType, priority); err != nil {
+		return err
+	}
+
+	rule := &vpc.AddNetworkAclRuleParameter{
+		Priority:                  ncloud.Int32(int32(priority)),
+		ProtocolTypeCode:          ncloud.String(d.Get("protocol").(string)),
+		RuleActionCode:            ncloud.String(d.Get("rule_action").(string)),
+		IpBlock:                   ncloud.String(d.Get("ip_block").(string)),
+		DenyAllowGroupNo:          ncloud.String(d.Get("deny_allow_group_no").(string)),
+		PortRange:                 ncloud.String(d.Get("port_range").(string)),
+		NetworkAclRuleDescription: ncloud.String(d.Get("description").(string)),
+	}
+
+	if err := addNetworkACLRule(d, config, networkACLNo, ruleType, []*vpc.AddNetworkAclRuleParameter{rule}); err != nil {
+		return err
+	}
+
+	d.SetId(networkACLSingleRuleId(networkACLNo, priority))
+	log.Printf("[INFO] Network ACL %s rule ID: %s", ruleType, d.Id())
+
+	return resourceNcloudNetworkACLSingleRuleRead(d, meta, ruleType)
+}
+
+func resourceNcloudNetworkACLSingleRuleRead(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	networkACLNo := d.Get("network_acl_no").(string)
+	instance, err := GetNetworkACLInstance(config, networkACLNo)
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		log.Printf("[WARN] Network ACL (%s) not found, removing %s rule (%s) from state", networkACLNo, ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The rule list is not accessible while the rules are changing, do not treat it as removed
+	rules, err := GetNetworkACLRuleList(config, networkACLNo)
+	if err != nil {
+		return err
+	}
+
+	rule := findNetworkACLSingleRule(rules, ruleType, d.Get("priority").(int))
+	if rule == nil {
+		log.Printf("[WARN] Network ACL %s rule (%s) not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("priority", int(ncloud.Int32Value(rule.Priority)))
+	d.Set("protocol", rule.ProtocolType.Code)
+	d.Set("rule_action", rule.RuleAction.Code)
+	d.Set("ip_block", rule.IpBlock)
+	d.Set("deny_allow_group_no", rule.DenyAllowGroupNo)
+	d.Set("port_range", rule.PortRange)
+	d.Set("description", rule.NetworkAclRuleDescription)
+
+	return nil
+}
+
+func resourceNcloudNetworkACLSingleRuleDelete(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	networkACLNo := d.Get("network_acl_no").(string)
+	instance, err := GetNetworkACLInstance(config, networkACLNo)
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		return nil
+	}
+
+	rule := &vpc.RemoveNetworkAclRuleParameter{
+		Priority:         ncloud.Int32(int32(d.Get("priority").(int))),
+		ProtocolTypeCode: ncloud.String(d.Get("protocol").(string)),
+		RuleActionCode:   ncloud.String(d.Get("rule_action").(string)),
+		IpBlock:          ncloud.String(d.Get("ip_block").(string)),
+		DenyAllowGroupNo: ncloud.String(d.Get("deny_allow_group_no").(string)),
+		PortRange:        ncloud.String(d.Get("port_range").(string)),
+	}
+
+	return removeNetworkACLRule(d, config, networkACLNo, ruleType, []*vpc.RemoveNetworkAclRuleParameter{rule})
+}
+
+func resourceNcloudNetworkACLSingleRuleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected NETWORK_ACL_NO:PRIORITY", d.Id())
+	}
+
+	priority, err := strconv.Atoi(idParts[1])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%q), PRIORITY must be a number", d.Id())
+	}
+
+	d.Set("network_acl_no", idParts[0])
+	d.Set("priority", priority)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceNcloudNetworkACLSingleRuleCustomizeDiff fails the plan when the priority is already used in the Network ACL,
+// e.g. by a rule resource owned by another configuration
+func resourceNcloudNetworkACLSingleRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if !config.SupportVPC {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("network_acl_no") && !diff.HasChange("priority") {
+		return nil
+	}
+
+	// The Network ACL is created in the same plan, checked at apply time
+	if !diff.NewValueKnown("network_acl_no") || !diff.NewValueKnown("priority") {
+		return nil
+	}
+
+	return checkNetworkACLSingleRulePriority(config, diff.Get("network_acl_no").(string), ruleType, diff.Get("priority").(int))
+}
+
+func checkNetworkACLSingleRulePriority(config *conn.ProviderConfig, networkACLNo, ruleType string, priority int) error {
+	rules, err := GetNetworkACLRuleList(config, networkACLNo)
+	if err != nil {
+		return err
+	}
+
+	if rule := findNetworkACLSingleRule(rules, ruleType, priority); rule != nil {
+		return fmt.Errorf("priority %d is already used by the %s rule (protocol: %s, port_range: %q) of Network ACL (%s)",
+			priority, ruleType, ncloud.StringValue(rule.ProtocolType.Code), ncloud.StringValue(rule.PortRange), networkACLNo)
+	}
+
+	return nil
+}
+
+func networkACLSingleRuleId(networkACLNo string, priority int) string {
+	return fmt.Sprintf("%s:%d", networkACLNo, priority)
+}
+
+func networkACLSingleRuleName(ruleType string) string {
+	if ruleType == "inbound" {
+		return "ingress"
+	}
+	return "egress"
+}
+
+func findNetworkACLSingleRule(rules []*vpc.NetworkAclRule, ruleType string, priority int) *vpc.NetworkAclRule {
+	ruleTypeCode := "OTBND"
+	if ruleType == "inbound" {
+		ruleTypeCode = "INBND"
+	}
+
+	for _, r := range rules {
+		if ncloud.StringValue(r.NetworkAclRuleType.Code) == ruleTypeCode && int(ncloud.Int32Value(r.Priority)) == priority {
+			return r
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudNetworkACLIngressEgressRule_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nacl-single-rule-%s", acctest.RandString(5))
+	ingressName := "ncloud_network_acl_ingress_rule.http"
+	egressName := "ncloud_network_acl_egress_rule.all"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNetworkACLIngressEgressRuleConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(ingressName, "network_acl_no", "ncloud_network_acl.nacl", "id"),
+					resource.TestCheckResourceAttr(ingressName, "priority", "10"),
+					resource.TestCheckResourceAttr(ingressName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(ingressName, "port_range", "80"),
+					resource.TestCheckResourceAttr(ingressName, "rule_action", "ALLOW"),
+					resource.TestCheckResourceAttr(egressName, "priority", "10"),
+					resource.TestCheckResourceAttr(egressName, "port_range", "1-65535"),
+				),
+			},
+			{
+				ResourceName:      ingressName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      egressName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccResourceNcloudNetworkACLIngressEgressRuleConfigDuplicate(name),
+				ExpectError: regexp.MustCompile("priority 10 is already used"),
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudNetworkACLIngressEgressRuleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_network_acl" "nacl" {
+	vpc_no      = ncloud_vpc.vpc.vpc_no
+	name        = "%[1]s"
+	description = "test acc for network acl single rule"
+}
+
+resource "ncloud_network_acl_ingress_rule" "http" {
+	network_acl_no = ncloud_network_acl.nacl.id
+	priority       = 10
+	protocol       = "TCP"
+	rule_action    = "ALLOW"
+	ip_block       = "0.0.0.0/0"
+	port_range     = "80"
+}
+
+resource "ncloud_network_acl_egress_rule" "all" {
+	network_acl_no = ncloud_network_acl.nacl.id
+	priority       = 10
+	protocol       = "TCP"
+	rule_action    = "ALLOW"
+	ip_block       = "0.0.0.0/0"
+	port_range     = "1-65535"
+}
+`, name)
+}
+
+func testAccResourceNcloudNetworkACLIngressEgressRuleConfigDuplicate(name string) string {
+	return testAccResourceNcloudNetworkACLIngressEgressRuleConfig(name) + `
+resource "ncloud_network_acl_ingress_rule" "https" {
+	network_acl_no = ncloud_network_acl.nacl.id
+	priority       = 10
+	protocol       = "TCP"
+	rule_action    = "ALLOW"
+	ip_block       = "0.0.0.0/0"
+	port_range     = "443"
+}
+`
+}
@@ -29,94 +29,54 @@ func ResourceNcloudNetworkACLRule() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"inbound": {
-				Type:       schema.TypeSet,
-				Optional:   true,
-				ConfigMode: schema.SchemaConfigModeAttr,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"priority": {
-							Type:             schema.TypeInt,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 199)),
-						},
-						"protocol": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"TCP", "UDP", "ICMP"}, false)),
-						},
-						"ip_block": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
-						},
-						"deny_allow_group_no": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"rule_action": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALLOW", "DROP"}, false)),
-						},
-						"port_range": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
-							Default:          "",
-						},
-						"description": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
-							Default:          "",
-						},
-					},
+			"inbound":  networkACLRuleSchema(),
+			"outbound": networkACLRuleSchema(),
+		},
+	}
+}
+
+func networkACLRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:       schema.TypeSet,
+		Optional:   true,
+		ConfigMode: schema.SchemaConfigModeAttr,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"priority": {
+					Type:             schema.TypeInt,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 199)),
 				},
-			},
-			"outbound": {
-				Type:       schema.TypeSet,
-				Optional:   true,
-				ConfigMode: schema.SchemaConfigModeAttr,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"priority": {
-							Type:             schema.TypeInt,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 199)),
-						},
-						"protocol": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"TCP", "UDP", "ICMP"}, false)),
-						},
-						"ip_block": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
-						},
-						"deny_allow_group_no": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"rule_action": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALLOW", "DROP"}, false)),
-						},
-						"port_range": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
-							Default:          "",
-						},
-						"description": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
-							Default:          "",
-						},
-					},
+				"protocol": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"TCP", "UDP", "ICMP"}, false)),
+				},
+				"ip_block": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
+				},
+				"deny_allow_group_no": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"rule_action": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALLOW", "DROP"}, false)),
+				},
+				"port_range": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
+					Default:          "",
+				},
+				"description": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+					Default:          "",
 				},
 			},
 		},
@@ -155,27 +115,7 @@ func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("network_acl_no", d.Id())
 
-	// Create empty set for getNetworkACLRuleList
-	iSet := schema.NewSet(schema.HashResource(ResourceNcloudNetworkACLRule().Schema["inbound"].Elem.(*schema.Resource)), []interface{}{})
-	oSet := schema.NewSet(schema.HashResource(ResourceNcloudNetworkACLRule().Schema["outbound"].Elem.(*schema.Resource)), []interface{}{})
-
-	for _, r := range rules {
-		m := map[string]interface{}{
-			"priority":            int(*r.Priority),
-			"protocol":            *r.ProtocolType.Code,
-			"port_range":          *r.PortRange,
-			"rule_action":         *r.RuleAction.Code,
-			"ip_block":            *r.IpBlock,
-			"deny_allow_group_no": *r.DenyAllowGroupNo,
-			"description":         *r.NetworkAclRuleDescription,
-		}
-
-		if *r.NetworkAclRuleType.Code == "INBND" {
-			iSet.Add(m)
-		} else {
-			oSet.Add(m)
-		}
-	}
+	iSet, oSet := flattenNetworkACLRules(rules)
 
 	// Only set data intersection between resource and list
 	if err := d.Set("inbound", iSet.List()); err != nil {
@@ -216,13 +156,13 @@ func resourceNcloudNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}
 	_ = waitForNcloudNetworkACLRunning(config, d.Id())
 
 	if len(i.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
+		if err := removeNetworkACLRule(d, config, d.Id(), "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
 			return err
 		}
 	}
 
 	if len(o.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
+		if err := removeNetworkACLRule(d, config, d.Id(), "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
 			return err
 		}
 	}
@@ -277,9 +217,11 @@ func updateNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 		n = new(schema.Set)
 	}
 
-	os := o.(*schema.Set)
-	ns := n.(*schema.Set)
+	return replaceNetworkACLRule(d, config, ruleType, o.(*schema.Set), n.(*schema.Set))
+}
 
+// replaceNetworkACLRule removes the rules only in os and adds the rules only in ns
+func replaceNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, os, ns *schema.Set) error {
 	add := ns.Difference(os).List()
 	remove := os.Difference(ns).List()
 
@@ -287,13 +229,13 @@ func updateNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 	addNetworkACLRuleList := expandAddNetworkAclRule(add)
 
 	if len(removeNetworkACLRuleList) > 0 {
-		if err := removeNetworkACLRule(d, config, ruleType, removeNetworkACLRuleList); err != nil {
+		if err := removeNetworkACLRule(d, config, d.Id(), ruleType, removeNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
 
 	if len(addNetworkACLRuleList) > 0 {
-		if err := addNetworkACLRule(d, config, ruleType, addNetworkACLRuleList); err != nil {
+		if err := addNetworkACLRule(d, config, d.Id(), ruleType, addNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
@@ -301,7 +243,33 @@ func updateNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 	return nil
 }
 
-func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
+func flattenNetworkACLRules(rules []*vpc.NetworkAclRule) (*schema.Set, *schema.Set) {
+	// Create empty set for getNetworkACLRuleList
+	iSet := schema.NewSet(schema.HashResource(networkACLRuleSchema().Elem.(*schema.Resource)), []interface{}{})
+	oSet := schema.NewSet(schema.HashResource(networkACLRuleSchema().Elem.(*schema.Resource)), []interface{}{})
+
+	for _, r := range rules {
+		m := map[string]interface{}{
+			"priority":            int(*r.Priority),
+			"protocol":            *r.ProtocolType.Code,
+			"port_range":          *r.PortRange,
+			"rule_action":         *r.RuleAction.Code,
+			"ip_block":            *r.IpBlock,
+			"deny_allow_group_no": *r.DenyAllowGroupNo,
+			"description":         *r.NetworkAclRuleDescription,
+		}
+
+		if *r.NetworkAclRuleType.Code == "INBND" {
+			iSet.Add(m)
+		} else {
+			oSet.Add(m)
+		}
+	}
+
+	return iSet, oSet
+}
+
+func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, networkACLNo string, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
@@ -311,7 +279,7 @@ func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, rule
 		if ruleType == "inbound" {
 			reqParams = &vpc.AddNetworkAclInboundRuleRequest{
 				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
+				NetworkAclNo:       ncloud.String(networkACLNo),
 				NetworkAclRuleList: addNetworkRuleList,
 			}
 
@@ -320,7 +288,7 @@ func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, rule
 		} else {
 			reqParams = &vpc.AddNetworkAclOutboundRuleRequest{
 				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
+				NetworkAclNo:       ncloud.String(networkACLNo),
 				NetworkAclRuleList: addNetworkRuleList,
 			}
 
@@ -347,14 +315,14 @@ func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, rule
 
 	LogResponse("AddNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(config, networkACLNo); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
+func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, networkACLNo string, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
@@ -364,7 +332,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 		if ruleType == "inbound" {
 			reqParams = &vpc.RemoveNetworkAclInboundRuleRequest{
 				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
+				NetworkAclNo:       ncloud.String(networkACLNo),
 				NetworkAclRuleList: removeNetworkRuleList,
 			}
 
@@ -373,7 +341,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 		} else {
 			reqParams = &vpc.RemoveNetworkAclOutboundRuleRequest{
 				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
+				NetworkAclNo:       ncloud.String(networkACLNo),
 				NetworkAclRuleList: removeNetworkRuleList,
 			}
 
@@ -400,7 +368,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 
 	LogResponse("RemoveNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(config, networkACLNo); err != nil {
 		return err
 	}
 
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -58,6 +57,7 @@ func (s *subnetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators:  verify.InstanceNameValidator(),
@@ -85,7 +85,8 @@ func (s *subnetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"network_acl_no": schema.StringAttribute{
-				Required: true,
+				Required:    true,
+				Description: "The Network ACL associated with the subnet. Changed in place without replacing the subnet.",
 			},
 			"subnet_type": schema.StringAttribute{
 				Required: true,
@@ -100,6 +101,7 @@ func (s *subnetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -108,6 +110,9 @@ func (s *subnetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"subnet_no": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -170,15 +175,13 @@ func (s *subnetResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	err := sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
 		var err error
-		tflog.Info(ctx, "CreateSubnet", map[string]any{
-			"reqParams": common.MarshalUncheckedString(reqParams),
-		})
+		common.LogCommonRequestContext(ctx, "CreateSubnet", reqParams)
 		response, err = s.config.Client.Vpc.V2Api.CreateSubnet(reqParams)
 
 		if err != nil {
 			errBody, _ := common.GetCommonErrorBody(err)
 			if errBody.ReturnCode == "1001015" || errBody.ReturnCode == SubnetPleaseTryAgainErrorCode {
-				common.LogErrorResponse("retry CreateSubnet", err, reqParams)
+				common.LogErrorResponseContext(ctx, "retry CreateSubnet", err, reqParams)
 				time.Sleep(time.Second * 5)
 				return sdkresource.RetryableError(err)
 			}
@@ -254,9 +257,7 @@ func (s *subnetResource) Update(ctx context.Context, req resource.UpdateRequest,
 			SubnetNo:     state.SubnetNo.ValueStringPointer(),
 		}
 
-		tflog.Info(ctx, "SetSubnetNetworkAcl", map[string]any{
-			"reqParams": common.MarshalUncheckedString(reqParams),
-		})
+		common.LogCommonRequestContext(ctx, "SetSubnetNetworkAcl", reqParams)
 		response, err := s.config.Client.Vpc.V2Api.SetSubnetNetworkAcl(reqParams)
 
 		if err != nil {
@@ -267,15 +268,14 @@ func (s *subnetResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
-		tflog.Info(ctx, "SetSubnetNetworkAcl", map[string]any{
-			"updateSubnetResponse": common.MarshalUncheckedString(response),
-		})
+		common.LogResponseContext(ctx, "SetSubnetNetworkAcl", response)
 
 		if err := waitForNcloudNetworkACLUpdate(s.config, plan.NetworkAclNo.ValueString()); err != nil {
 			resp.Diagnostics.AddError(
 				"fail to wait for subnet update",
 				err.Error(),
 			)
+			return
 		}
 
 		output, err := GetSubnetInstance(s.config, state.ID.ValueString())
@@ -284,10 +284,14 @@ func (s *subnetResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
+		if output == nil {
+			resp.Diagnostics.AddError("GetSubnet", fmt.Sprintf("no matching subnet: %s", state.ID.ValueString()))
+			return
+		}
+
 		if err := state.refreshFromOutput(output); err != nil {
 			resp.Diagnostics.AddError("refreshing subnet details", err.Error())
 		}
-
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -305,9 +309,7 @@ func (s *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		SubnetNo:   state.SubnetNo.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteSubnet", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "DeleteSubnet", reqParams)
 	response, err := s.config.Client.Vpc.V2Api.DeleteSubnet(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -316,9 +318,7 @@ func (s *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		)
 		return
 	}
-	tflog.Info(ctx, "DeleteSubnet response", map[string]any{
-		"deleteSubnetResponse": common.MarshalUncheckedString(response),
-	})
+	common.LogResponseContext(ctx, "DeleteSubnet", response)
 
 	if err := WaitForNcloudSubnetDeletion(s.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -151,23 +150,19 @@ func (s *subnetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		reqParams.UsageTypeCode = data.UsageType.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetSubnetList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "GetSubnetList", reqParams)
 	subnetResp, err := s.config.Client.Vpc.V2Api.GetSubnetList(reqParams)
 
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(
 			"GetSubnetList",
-			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.MarshalUncheckedString(reqParams)),
+			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.RedactLogValue(reqParams)),
 		)
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Info(ctx, "GetSubnetList response", map[string]any{
-		"subnetResponse": common.MarshalUncheckedString(subnetResp),
-	})
+	common.LogResponseContext(ctx, "GetSubnetList", subnetResp)
 
 	subnetList, diags := flattenSubnets(subnetResp.SubnetList, s.config)
 	resp.Diagnostics.Append(diags...)
@@ -6,12 +6,15 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/mockapi"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	vpcservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 )
@@ -129,6 +132,45 @@ func TestAccResourceNcloudSubnet_updateNetworkACL(t *testing.T) {
 	})
 }
 
+func TestUnitResourceNcloudSubnet_updateNetworkACL(t *testing.T) {
+	var before, after vpc.Subnet
+	name := "test-subnet-unit-nacl"
+	cidr := "10.2.2.0/24"
+	resourceName := "ncloud_subnet.bar"
+
+	server := mockapi.NewServer()
+	defer server.Close()
+	factories, provider := MockProtoV6ProviderFactories(server)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { MockPreCheck(t) },
+		ProtoV6ProviderFactories: factories,
+		CheckDestroy:             testAccCheckSubnetDestroyWithProvider(provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudSubnetConfigNetworkACL(name, cidr, "ncloud_vpc.foo.default_network_acl_no"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExistsWithProvider(resourceName, &before, provider),
+				),
+			},
+			{
+				// name and usage_type are not set, changing the Network ACL must not replace the subnet
+				Config: testAccResourceNcloudSubnetConfigNetworkACL(name, cidr, "ncloud_network_acl.nacl.network_acl_no"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExistsWithProvider(resourceName, &after, provider),
+					resource.TestCheckResourceAttrPair(resourceName, "network_acl_no", "ncloud_network_acl.nacl", "network_acl_no"),
+					func(*terraform.State) error {
+						if ncloud.StringValue(before.SubnetNo) != ncloud.StringValue(after.SubnetNo) {
+							return fmt.Errorf("subnet was replaced: %s -> %s", ncloud.StringValue(before.SubnetNo), ncloud.StringValue(after.SubnetNo))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNcloudSubnet_InvalidCIDR(t *testing.T) {
 	name := fmt.Sprintf("test-subnet-update-nacl-%s", sdkacctest.RandString(5))
 	cidr := "10.3.2.0/24"
@@ -190,6 +232,28 @@ resource "ncloud_subnet" "bar" {
 `, name, cidr)
 }
 
+func testAccResourceNcloudSubnetConfigNetworkACL(name, cidr, networkACLNo string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "foo" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.2.0.0/16"
+}
+
+resource "ncloud_network_acl" "nacl" {
+	vpc_no      = ncloud_vpc.foo.vpc_no
+	name        = "%[1]s"
+}
+
+resource "ncloud_subnet" "bar" {
+	vpc_no             = ncloud_vpc.foo.vpc_no
+	subnet             = "%[2]s"
+	zone               = "KR-1"
+	network_acl_no     = %[3]s
+	subnet_type        = "PUBLIC"
+}
+`, name, cidr, networkACLNo)
+}
+
 func testAccResourceNcloudSubnetConfigInvalidCIDR(name, cidr string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "foo" {
@@ -210,6 +274,10 @@ resource "ncloud_subnet" "bar" {
 }
 
 func testAccCheckSubnetExists(n string, subnet *vpc.Subnet) resource.TestCheckFunc {
+	return testAccCheckSubnetExistsWithProvider(n, subnet, GetTestProvider(true))
+}
+
+func testAccCheckSubnetExistsWithProvider(n string, subnet *vpc.Subnet, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -220,12 +288,16 @@ func testAccCheckSubnetExists(n string, subnet *vpc.Subnet) resource.TestCheckFu
 			return fmt.Errorf("No subnet no is set")
 		}
 
-		config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+		config := provider.Meta().(*conn.ProviderConfig)
 		instance, err := vpcservice.GetSubnetInstance(config, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
 
+		if instance == nil {
+			return fmt.Errorf("Subnet (%s) not found", rs.Primary.ID)
+		}
+
 		*subnet = *instance
 
 		return nil
@@ -233,25 +305,31 @@ func testAccCheckSubnetExists(n string, subnet *vpc.Subnet) resource.TestCheckFu
 }
 
 func testAccCheckSubnetDestroy(s *terraform.State) error {
-	config := GetTestProvider(true).Meta().(*conn.ProviderConfig)
+	return testAccCheckSubnetDestroyWithProvider(GetTestProvider(true))(s)
+}
 
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "ncloud_subnet" {
-			continue
-		}
+func testAccCheckSubnetDestroyWithProvider(provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := provider.Meta().(*conn.ProviderConfig)
 
-		instance, err := vpcservice.GetSubnetInstance(config, rs.Primary.ID)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "ncloud_subnet" {
+				continue
+			}
 
-		if err != nil {
-			return err
-		}
+			instance, err := vpcservice.GetSubnetInstance(config, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
 
-		if instance != nil {
-			return errors.New("Subnet still exists")
+			if instance != nil {
+				return errors.New("Subnet still exists")
+			}
 		}
-	}
 
-	return nil
+		return nil
+	}
 }
 
 func testAccCheckSubnetDisappears(instance *vpc.Subnet) resource.TestCheckFunc {
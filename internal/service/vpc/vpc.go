@@ -127,9 +127,7 @@ func (r *vpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 		reqParams.VpcName = plan.Name.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateVpc", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "CreateVpc", reqParams)
 	response, err := r.config.Client.Vpc.V2Api.CreateVpc(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -138,9 +136,7 @@ func (r *vpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 		)
 		return
 	}
-	tflog.Info(ctx, "CreateVpc response", map[string]any{
-		"createVpcResponse": common.MarshalUncheckedString(response),
-	})
+	common.LogResponseContext(ctx, "CreateVpc", response)
 
 	vpcInstance := response.VpcList[0]
 	plan.ID = types.StringPointerValue(vpcInstance.VpcNo)
@@ -188,6 +184,7 @@ func (r *vpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 }
 
+// Update is never called. The API has no operation to change a VPC, every argument requires replacement.
 func (r *vpcResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 }
 
@@ -204,9 +201,7 @@ func (r *vpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		VpcNo:      state.VpcNo.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteVpc", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "DeleteVpc", reqParams)
 	response, err := r.config.Client.Vpc.V2Api.DeleteVpc(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -215,9 +210,7 @@ func (r *vpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		)
 		return
 	}
-	tflog.Info(ctx, "DeleteVpc response", map[string]any{
-		"deleteVpcResponse": common.MarshalUncheckedString(response),
-	})
+	common.LogResponseContext(ctx, "DeleteVpc", response)
 
 	if err := WaitForNcloudVpcDeletion(r.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
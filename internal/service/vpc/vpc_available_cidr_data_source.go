@@ -0,0 +1,252 @@
+package vpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+var (
+	_ datasource.DataSource              = &vpcAvailableCidrDataSource{}
+	_ datasource.DataSourceWithConfigure = &vpcAvailableCidrDataSource{}
+)
+
+const subnetListPageSize = 100
+
+func NewVpcAvailableCidrDataSource() datasource.DataSource {
+	return &vpcAvailableCidrDataSource{}
+}
+
+type vpcAvailableCidrDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (v *vpcAvailableCidrDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_available_cidr"
+}
+
+// Schema defines the schema for the data source.
+func (v *vpcAvailableCidrDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"vpc_no": schema.StringAttribute{
+				Required:    true,
+				Description: "The VPC ID to allocate CIDR blocks from",
+			},
+			"prefix_length": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(16, 28),
+				},
+				Description: "The prefix length of the CIDR blocks to return. e.g. 24 for a /24 subnet",
+			},
+			"cidr_count": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The number of CIDR blocks to return. default: 1",
+			},
+			"offset": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "The number of free CIDR blocks to skip before the returned ones. default: 0",
+			},
+			"cidr_blocks": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The free CIDR blocks, in ascending address order",
+			},
+		},
+	}
+}
+
+func (v *vpcAvailableCidrDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.config = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (v *vpcAvailableCidrDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !v.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"Not Supported Classic",
+			"vpc_available_cidr data source does not supported in classic",
+		)
+		return
+	}
+
+	var data vpcAvailableCidrDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count := 1
+	if !data.CidrCount.IsNull() && !data.CidrCount.IsUnknown() {
+		count = int(data.CidrCount.ValueInt64())
+	}
+
+	cidrBlocks, err := getAvailableCidrBlocks(ctx, v.config, data.VpcNo.ValueString(), int(data.PrefixLength.ValueInt64()), int(data.Offset.ValueInt64()), count)
+	if err != nil {
+		resp.Diagnostics.AddError("allocating CIDR blocks", err.Error())
+		return
+	}
+
+	state := data
+	state.ID = data.VpcNo
+	cidrBlocksValue, diags := types.ListValueFrom(ctx, types.StringType, cidrBlocks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CidrBlocks = cidrBlocksValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getAvailableCidrBlocks returns count free CIDR blocks of prefixLength in the VPC, after skipping the first offset ones.
+// Subnet CIDR blocks may not overlap anywhere in a VPC, so the subnets of every zone and usage type are used.
+func getAvailableCidrBlocks(ctx context.Context, config *conn.ProviderConfig, vpcNo string, prefixLength, offset, count int) ([]string, error) {
+	vpcInstance, err := GetVpcInstance(config, vpcNo)
+	if err != nil {
+		return nil, err
+	}
+
+	if vpcInstance == nil {
+		return nil, fmt.Errorf("no matching VPC: %s", vpcNo)
+	}
+
+	usedCidrBlocks, err := getSubnetCidrBlocks(ctx, config, vpcNo)
+	if err != nil {
+		return nil, err
+	}
+
+	return nextAvailableCidrBlocks(ncloud.StringValue(vpcInstance.Ipv4CidrBlock), usedCidrBlocks, prefixLength, offset, count)
+}
+
+// getSubnetCidrBlocks returns the CIDR blocks of all subnets in the VPC, a page at a time.
+func getSubnetCidrBlocks(ctx context.Context, config *conn.ProviderConfig, vpcNo string) ([]string, error) {
+	var cidrBlocks []string
+
+	for pageNo := int32(1); ; pageNo++ {
+		reqParams := &vpc.GetSubnetListRequest{
+			RegionCode: &config.RegionCode,
+			VpcNo:      ncloud.String(vpcNo),
+			PageNo:     ncloud.Int32(pageNo),
+			PageSize:   ncloud.Int32(subnetListPageSize),
+		}
+
+		common.LogCommonRequestContext(ctx, "GetSubnetList", reqParams)
+		resp, err := config.Client.Vpc.V2Api.GetSubnetList(reqParams)
+		if err != nil {
+			return nil, fmt.Errorf("GetSubnetList error: %s, reqParams: %s", err.Error(), common.RedactLogValue(reqParams))
+		}
+		common.LogResponseContext(ctx, "GetSubnetList", resp)
+
+		for _, s := range resp.SubnetList {
+			cidrBlocks = append(cidrBlocks, ncloud.StringValue(s.Subnet))
+		}
+
+		if len(resp.SubnetList) == 0 || len(cidrBlocks) >= int(ncloud.Int32Value(resp.TotalRows)) {
+			break
+		}
+	}
+
+	return cidrBlocks, nil
+}
+
+// nextAvailableCidrBlocks returns count CIDR blocks of prefixLength inside vpcCidrBlock which do not overlap any of
+// usedCidrBlocks, after skipping the first offset ones.
+func nextAvailableCidrBlocks(vpcCidrBlock string, usedCidrBlocks []string, prefixLength, offset, count int) ([]string, error) {
+	if err := verify.ValidateCIDRBlock(vpcCidrBlock); err != nil {
+		return nil, err
+	}
+
+	_, vpcNet, _ := net.ParseCIDR(vpcCidrBlock)
+	vpcPrefixLength, bits := vpcNet.Mask.Size()
+	if bits != 32 {
+		return nil, fmt.Errorf("%q is not an IPv4 CIDR block", vpcCidrBlock)
+	}
+
+	if prefixLength < vpcPrefixLength || prefixLength > bits {
+		return nil, fmt.Errorf("prefix length %d is out of range of the VPC CIDR block %s", prefixLength, vpcCidrBlock)
+	}
+
+	start := uint64(binary.BigEndian.Uint32(vpcNet.IP.To4()))
+	end := start + uint64(1)<<(bits-vpcPrefixLength)
+	size := uint64(1) << (bits - prefixLength)
+
+	var cidrBlocks []string
+	skipped := 0
+	for addr := start; addr < end && len(cidrBlocks) < count; addr += size {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, uint32(addr))
+		candidate := fmt.Sprintf("%s/%d", ip, prefixLength)
+
+		used := false
+		for _, usedCidrBlock := range usedCidrBlocks {
+			if verify.CIDRBlocksOverlap(candidate, usedCidrBlock) {
+				used = true
+				break
+			}
+		}
+
+		if used {
+			continue
+		}
+
+		if skipped < offset {
+			skipped++
+			continue
+		}
+
+		cidrBlocks = append(cidrBlocks, candidate)
+	}
+
+	if len(cidrBlocks) < count {
+		return nil, fmt.Errorf("only %d free /%d CIDR blocks are available in %s, %d requested after skipping %d", skipped+len(cidrBlocks), prefixLength, vpcCidrBlock, count, offset)
+	}
+
+	return cidrBlocks, nil
+}
+
+type vpcAvailableCidrDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	VpcNo        types.String `tfsdk:"vpc_no"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	CidrCount    types.Int64  `tfsdk:"cidr_count"`
+	Offset       types.Int64  `tfsdk:"offset"`
+	CidrBlocks   types.List   `tfsdk:"cidr_blocks"`
+}
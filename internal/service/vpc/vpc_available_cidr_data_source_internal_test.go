@@ -0,0 +1,160 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/mockapi"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestNextAvailableCidrBlocks(t *testing.T) {
+	cases := []struct {
+		Name         string
+		VpcCidr      string
+		Used         []string
+		PrefixLength int
+		Offset       int
+		Count        int
+		Expected     []string
+		HasError     bool
+	}{
+		{
+			Name:         "empty vpc",
+			VpcCidr:      "10.0.0.0/16",
+			PrefixLength: 24,
+			Count:        2,
+			Expected:     []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+		{
+			Name:         "skip used subnets",
+			VpcCidr:      "10.0.0.0/16",
+			Used:         []string{"10.0.0.0/24", "10.0.2.0/24"},
+			PrefixLength: 24,
+			Count:        2,
+			Expected:     []string{"10.0.1.0/24", "10.0.3.0/24"},
+		},
+		{
+			Name:         "smaller used subnet blocks the whole candidate",
+			VpcCidr:      "10.0.0.0/16",
+			Used:         []string{"10.0.0.16/28"},
+			PrefixLength: 24,
+			Count:        1,
+			Expected:     []string{"10.0.1.0/24"},
+		},
+		{
+			Name:         "larger used subnet blocks all candidates inside",
+			VpcCidr:      "10.0.0.0/16",
+			Used:         []string{"10.0.0.0/23"},
+			PrefixLength: 25,
+			Count:        1,
+			Expected:     []string{"10.0.2.0/25"},
+		},
+		{
+			Name:         "skip free blocks by offset",
+			VpcCidr:      "10.0.0.0/16",
+			Used:         []string{"10.0.1.0/24"},
+			PrefixLength: 24,
+			Offset:       2,
+			Count:        2,
+			Expected:     []string{"10.0.3.0/24", "10.0.4.0/24"},
+		},
+		{
+			Name:         "not enough space after offset",
+			VpcCidr:      "10.0.0.0/23",
+			PrefixLength: 24,
+			Offset:       2,
+			Count:        1,
+			HasError:     true,
+		},
+		{
+			Name:         "not enough space",
+			VpcCidr:      "10.0.0.0/23",
+			Used:         []string{"10.0.0.0/24"},
+			PrefixLength: 24,
+			Count:        2,
+			HasError:     true,
+		},
+		{
+			Name:         "prefix length shorter than vpc",
+			VpcCidr:      "10.0.0.0/24",
+			PrefixLength: 16,
+			Count:        1,
+			HasError:     true,
+		},
+		{
+			Name:         "invalid vpc cidr block",
+			VpcCidr:      "10.0.0.1/16",
+			PrefixLength: 24,
+			Count:        1,
+			HasError:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := nextAvailableCidrBlocks(tc.VpcCidr, tc.Used, tc.PrefixLength, tc.Offset, tc.Count)
+		if (err != nil) != tc.HasError {
+			t.Fatalf("%s: error = %v, expected error: %t", tc.Name, err, tc.HasError)
+		}
+
+		if !tc.HasError && !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%s: expected %v, actual %v", tc.Name, tc.Expected, actual)
+		}
+	}
+}
+
+func TestGetAvailableCidrBlocks_allSubnets(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client, err := (&conn.Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: server.Endpoints(),
+	}).Client("public")
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR", Client: client}
+
+	vpcs, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{Ipv4CidrBlock: ncloud.String("10.0.0.0/16")})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	vpcNo := vpcs.VpcList[0].VpcNo
+	acls, _ := client.Vpc.V2Api.GetNetworkAclList(&vpc.GetNetworkAclListRequest{VpcNo: vpcNo})
+
+	createSubnet := func(zoneCode, cidrBlock string) {
+		if _, err := client.Vpc.V2Api.CreateSubnet(&vpc.CreateSubnetRequest{
+			VpcNo:          vpcNo,
+			ZoneCode:       ncloud.String(zoneCode),
+			Subnet:         ncloud.String(cidrBlock),
+			NetworkAclNo:   acls.NetworkAclList[0].NetworkAclNo,
+			SubnetTypeCode: ncloud.String("PRIVATE"),
+		}); err != nil {
+			t.Fatalf("Got error: %s", err)
+		}
+	}
+
+	// Subnets of every zone are used, and they take more than a page
+	createSubnet("KR-1", "10.0.0.0/24")
+	for i := 0; i < subnetListPageSize; i++ {
+		createSubnet("KR-2", fmt.Sprintf("10.0.%d.%d/28", 1+i/16, i%16*16))
+	}
+
+	cidrBlocks, err := getAvailableCidrBlocks(context.Background(), config, ncloud.StringValue(vpcNo), 24, 0, 2)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	expected := []string{"10.0.8.0/24", "10.0.9.0/24"}
+	if !reflect.DeepEqual(cidrBlocks, expected) {
+		t.Fatalf("Expected %v but %v", expected, cidrBlocks)
+	}
+}
@@ -0,0 +1,57 @@
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudVpcAvailableCidr_basic(t *testing.T) {
+	dataName := "data.ncloud_vpc_available_cidr.test"
+	name := fmt.Sprintf("test-available-cidr-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudVpcAvailableCidrConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttr(dataName, "cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr(dataName, "cidr_blocks.0", "10.6.0.0/24"),
+					resource.TestCheckResourceAttr(dataName, "cidr_blocks.1", "10.6.2.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNcloudVpcAvailableCidrConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.6.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no         = ncloud_vpc.test.vpc_no
+	name           = "%[1]s"
+	subnet         = "10.6.1.0/24"
+	zone           = "KR-2"
+	network_acl_no = ncloud_vpc.test.default_network_acl_no
+	subnet_type    = "PUBLIC"
+	usage_type     = "GEN"
+}
+
+data "ncloud_vpc_available_cidr" "test" {
+	vpc_no        = ncloud_subnet.test.vpc_no
+	prefix_length = 24
+	cidr_count    = 2
+}
+`, name)
+}
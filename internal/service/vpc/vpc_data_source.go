@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -116,23 +115,19 @@ func (v *vpcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		reqParams.VpcName = data.Name.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetVpcList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "GetVpcList", reqParams)
 	vpcResp, err := v.config.Client.Vpc.V2Api.GetVpcList(reqParams)
 
 	if err != nil {
 		var diags diag.Diagnostics
 		diags.AddError(
 			"GetVpcList",
-			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.MarshalUncheckedString(reqParams)),
+			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.RedactLogValue(reqParams)),
 		)
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Info(ctx, "GetVpcList response", map[string]any{
-		"vpcResponse": common.MarshalUncheckedString(vpcResp),
-	})
+	common.LogResponseContext(ctx, "GetVpcList", vpcResp)
 
 	vpcList, diags := flattenVpcs(ctx, vpcResp.VpcList, v.config)
 	resp.Diagnostics.Append(diags...)
@@ -176,9 +176,7 @@ func (v *vpcPeeringResource) Create(ctx context.Context, req resource.CreateRequ
 		reqParams.TargetVpcLoginId = plan.TargetVpcLoginId.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "CreateVpcPeering", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "CreateVpcPeering", reqParams)
 	response, err := v.config.Client.Vpc.V2Api.CreateVpcPeeringInstance(reqParams)
 
 	if err != nil {
@@ -189,18 +187,20 @@ func (v *vpcPeeringResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	tflog.Info(ctx, "CreateVpcPeering response", map[string]any{
-		"createVpcPeeringResponse": common.MarshalUncheckedString(resp),
-	})
+	common.LogResponseContext(ctx, "CreateVpcPeering", resp)
 
 	instance := response.VpcPeeringInstanceList[0]
 	plan.ID = types.StringPointerValue(instance.VpcPeeringInstanceNo)
 	tflog.Info(ctx, "VPC Peering ID: %s", map[string]any{"vpcPeeringNo": *instance.VpcPeeringInstanceNo})
 
-	output, err := waitForNcloudVpcPeeringCreation(ctx, v.config, *instance.VpcPeeringInstanceNo)
-	if err != nil {
-		resp.Diagnostics.AddError("waiting for Vpc peering creation", err.Error())
-		return
+	// A peering between accounts stays pending until the target account accepts it with ncloud_vpc_peering_accepter
+	output := instance
+	if !ncloud.BoolValue(instance.IsBetweenAccounts) {
+		output, err = waitForNcloudVpcPeeringCreation(ctx, v.config, *instance.VpcPeeringInstanceNo)
+		if err != nil {
+			resp.Diagnostics.AddError("waiting for Vpc peering creation", err.Error())
+			return
+		}
 	}
 
 	plan.refreshFromOutput(output)
@@ -250,9 +250,7 @@ func (v *vpcPeeringResource) Update(ctx context.Context, req resource.UpdateRequ
 			VpcPeeringDescription: plan.Description.ValueStringPointer(),
 		}
 
-		tflog.Info(ctx, "setVpcPeering", map[string]any{
-			"reqParams": common.MarshalUncheckedString(reqParams),
-		})
+		common.LogCommonRequestContext(ctx, "setVpcPeering", reqParams)
 
 		response, err := v.config.Client.Vpc.V2Api.SetVpcPeeringDescription(reqParams)
 		if err != nil {
@@ -263,9 +261,7 @@ func (v *vpcPeeringResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		tflog.Info(ctx, "SetVpcPeeringDescription", map[string]any{
-			"updateVpcPeeringResponse": common.MarshalUncheckedString(response),
-		})
+		common.LogResponseContext(ctx, "SetVpcPeeringDescription", response)
 
 		output, err := GetVpcPeeringInstance(ctx, v.config, state.ID.ValueString())
 		if err != nil {
@@ -291,9 +287,7 @@ func (v *vpcPeeringResource) Delete(ctx context.Context, req resource.DeleteRequ
 		VpcPeeringInstanceNo: state.VpcPeeringNo.ValueStringPointer(),
 	}
 
-	tflog.Info(ctx, "DeleteVpcPeering", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "DeleteVpcPeering", reqParams)
 	response, err := v.config.Client.Vpc.V2Api.DeleteVpcPeeringInstance(reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -303,9 +297,7 @@ func (v *vpcPeeringResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	tflog.Info(ctx, "DeleteVpcPeering response", map[string]any{
-		"deleteVpcPeeringResponse": common.MarshalUncheckedString(response),
-	})
+	common.LogResponseContext(ctx, "DeleteVpcPeering", response)
 
 	if err := WaitForNcloudVpcPeeringDeletion(ctx, v.config, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
@@ -332,6 +324,7 @@ func (m *vpcPeeringResourceModel) refreshFromOutput(output *vpc.VpcPeeringInstan
 func waitForNcloudVpcPeeringCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.VpcPeeringInstance, error) {
 	var vpcPeeringInstance *vpc.VpcPeeringInstance
 	stateConf := &sdkresource.StateChangeConf{
+		// A VPC peering between accounts is INIT until it is accepted, so wait for it only after accepting
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
 		Refresh: func() (interface{}, string, error) {
@@ -378,21 +371,15 @@ func GetVpcPeeringInstance(ctx context.Context, config *conn.ProviderConfig, id
 		VpcPeeringInstanceNo: ncloud.String(id),
 	}
 
-	tflog.Info(ctx, "GetVpcPeeringInstanceDetail", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "GetVpcPeeringInstanceDetail", reqParams)
 
 	resp, err := config.Client.Vpc.V2Api.GetVpcPeeringInstanceDetail(reqParams)
 	if err != nil {
-		tflog.Error(ctx, "GetVpcPeeringInstanceDetail", map[string]any{
-			"reqParams": common.MarshalUncheckedString(reqParams),
-		})
+		common.LogErrorResponseContext(ctx, "GetVpcPeeringInstanceDetail", err, reqParams)
 		return nil, err
 	}
 
-	tflog.Info(ctx, "GetVpcPeeringInstanceDetail", map[string]any{
-		"respParams": common.MarshalUncheckedString(resp),
-	})
+	common.LogResponseContext(ctx, "GetVpcPeeringInstanceDetail", resp)
 
 	if len(resp.VpcPeeringInstanceList) > 0 {
 		instance := resp.VpcPeeringInstanceList[0]
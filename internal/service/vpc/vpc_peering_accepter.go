@@ -0,0 +1,244 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &vpcPeeringAccepterResource{}
+	_ resource.ResourceWithConfigure   = &vpcPeeringAccepterResource{}
+	_ resource.ResourceWithImportState = &vpcPeeringAccepterResource{}
+)
+
+func NewVpcPeeringAccepterResource() resource.Resource {
+	return &vpcPeeringAccepterResource{}
+}
+
+type vpcPeeringAccepterResource struct {
+	config *conn.ProviderConfig
+}
+
+func (v *vpcPeeringAccepterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("vpc_peering_no"), req, resp)
+}
+
+func (v *vpcPeeringAccepterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering_accepter"
+}
+
+func (v *vpcPeeringAccepterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"vpc_peering_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The ID of VPC peering requested from the other account.",
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_vpc_no": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_vpc_login_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_vpc_no": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"has_reverse_vpc_peering": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_between_accounts": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (v *vpcPeeringAccepterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.config = config
+}
+
+func (v *vpcPeeringAccepterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcPeeringAccepterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !v.config.SupportVPC {
+		resp.Diagnostics.AddError(
+			"not support classic",
+			fmt.Sprintf("resource %s does not support classic", req.Config.Schema.Type().String()),
+		)
+		return
+	}
+
+	id := plan.VpcPeeringNo.ValueString()
+
+	output, err := acceptVpcPeering(ctx, v.config, id)
+	if err != nil {
+		resp.Diagnostics.AddError("accepting VPC Peering", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (v *vpcPeeringAccepterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcPeeringAccepterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetVpcPeeringInstance(ctx, v.config, state.VpcPeeringNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GetVpcPeering", err.Error())
+		return
+	}
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (v *vpcPeeringAccepterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete only removes the accepter from state. The VPC peering is owned and deleted by the requester account.
+func (v *vpcPeeringAccepterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcPeeringAccepterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "VPC Peering accepter removed from state, the VPC Peering is kept", map[string]any{
+		"vpcPeeringNo": state.VpcPeeringNo.ValueString(),
+	})
+}
+
+// acceptVpcPeering accepts the VPC peering requested from the other account and waits until it is active.
+// A VPC peering between accounts is INIT until it is accepted.
+func acceptVpcPeering(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.VpcPeeringInstance, error) {
+	instance, err := GetVpcPeeringInstance(ctx, config, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if instance == nil {
+		return nil, fmt.Errorf("no matching VPC Peering: %s", id)
+	}
+
+	// Skip accepting when it is not waiting for acceptance. e.g. accepted in the console before being codified
+	if status := instance.VpcPeeringInstanceStatus; ncloud.BoolValue(instance.IsBetweenAccounts) && status != nil && ncloud.StringValue(status.Code) == "INIT" {
+		reqParams := &vpc.AcceptOrRejectVpcPeeringRequest{
+			RegionCode:           &config.RegionCode,
+			VpcPeeringInstanceNo: ncloud.String(id),
+			IsAccept:             ncloud.Bool(true),
+		}
+
+		common.LogCommonRequestContext(ctx, "AcceptVpcPeering", reqParams)
+		response, err := config.Client.Vpc.V2Api.AcceptOrRejectVpcPeering(reqParams)
+		if err != nil {
+			common.LogErrorResponseContext(ctx, "AcceptVpcPeering", err, reqParams)
+			return nil, err
+		}
+		common.LogResponseContext(ctx, "AcceptVpcPeering", response)
+	}
+
+	return waitForNcloudVpcPeeringCreation(ctx, config, id)
+}
+
+func (m *vpcPeeringAccepterResourceModel) refreshFromOutput(output *vpc.VpcPeeringInstance) {
+	m.ID = types.StringPointerValue(output.VpcPeeringInstanceNo)
+	m.VpcPeeringNo = types.StringPointerValue(output.VpcPeeringInstanceNo)
+	m.Name = types.StringPointerValue(output.VpcPeeringName)
+	m.Description = types.StringPointerValue(output.VpcPeeringDescription)
+	m.SourceVpcNo = types.StringPointerValue(output.SourceVpcNo)
+	m.SourceVpcLoginId = types.StringPointerValue(output.SourceVpcLoginId)
+	m.TargetVpcNo = types.StringPointerValue(output.TargetVpcNo)
+	m.HasReverseVpcPeering = types.BoolPointerValue(output.HasReverseVpcPeering)
+	m.IsBetweenAccounts = types.BoolPointerValue(output.IsBetweenAccounts)
+}
+
+type vpcPeeringAccepterResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	VpcPeeringNo         types.String `tfsdk:"vpc_peering_no"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	SourceVpcNo          types.String `tfsdk:"source_vpc_no"`
+	SourceVpcLoginId     types.String `tfsdk:"source_vpc_login_id"`
+	TargetVpcNo          types.String `tfsdk:"target_vpc_no"`
+	HasReverseVpcPeering types.Bool   `tfsdk:"has_reverse_vpc_peering"`
+	IsBetweenAccounts    types.Bool   `tfsdk:"is_between_accounts"`
+}
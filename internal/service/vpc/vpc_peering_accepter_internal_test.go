@@ -0,0 +1,72 @@
+package vpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/mockapi"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAcceptVpcPeering_betweenAccounts(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client, err := (&conn.Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: server.Endpoints(),
+	}).Client("public")
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	config := &conn.ProviderConfig{SupportVPC: true, RegionCode: "KR", Client: client}
+
+	vpcs, err := client.Vpc.V2Api.CreateVpc(&vpc.CreateVpcRequest{Ipv4CidrBlock: ncloud.String("10.0.0.0/16")})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+
+	// Requested to the VPC of another account, so it waits for acceptance
+	peerings, err := client.Vpc.V2Api.CreateVpcPeeringInstance(&vpc.CreateVpcPeeringInstanceRequest{
+		SourceVpcNo:      vpcs.VpcList[0].VpcNo,
+		TargetVpcNo:      ncloud.String("20001"),
+		TargetVpcName:    ncloud.String("tf-target"),
+		TargetVpcLoginId: ncloud.String("other@example.com"),
+	})
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	id := ncloud.StringValue(peerings.VpcPeeringInstanceList[0].VpcPeeringInstanceNo)
+
+	instance, err := GetVpcPeeringInstance(context.Background(), config, id)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if status := ncloud.StringValue(instance.VpcPeeringInstanceStatus.Code); status != "INIT" {
+		t.Fatalf("VPC Peering expected INIT before acceptance but %s", status)
+	}
+
+	instance, err = acceptVpcPeering(context.Background(), config, id)
+	if err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if status := ncloud.StringValue(instance.VpcPeeringInstanceStatus.Code); status != "RUN" {
+		t.Fatalf("VPC Peering expected RUN but %s", status)
+	}
+	if calls := server.Calls("acceptOrRejectVpcPeering"); calls != 1 {
+		t.Fatalf("acceptOrRejectVpcPeering expected to be called 1 time but %d", calls)
+	}
+
+	// An active VPC Peering, e.g. accepted in the console, is not accepted again
+	if _, err := acceptVpcPeering(context.Background(), config, id); err != nil {
+		t.Fatalf("Got error: %s", err)
+	}
+	if calls := server.Calls("acceptOrRejectVpcPeering"); calls != 1 {
+		t.Fatalf("acceptOrRejectVpcPeering expected to be called 1 time but %d", calls)
+	}
+}
@@ -0,0 +1,53 @@
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudVpcPeeringAccepter_basic(t *testing.T) {
+	var vpcPeeringInstance vpc.VpcPeeringInstance
+	resourceName := "ncloud_vpc_peering_accepter.peer"
+	peeringName := "ncloud_vpc_peering.foo"
+	name := fmt.Sprintf("test-peering-accept-%s", sdkacctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVpcPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudVpcPeeringAccepterConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringExists(resourceName, &vpcPeeringInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_no", peeringName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_vpc_no", peeringName, "source_vpc_no"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_vpc_no", peeringName, "target_vpc_no"),
+					resource.TestMatchResourceAttr(resourceName, "source_vpc_login_id", regexp.MustCompile(`.+`)),
+					resource.TestCheckResourceAttr(resourceName, "has_reverse_vpc_peering", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_between_accounts", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudVpcPeeringAccepterConfig(name string) string {
+	return testAccResourceNcloudVpcPeeringConfig(name) + `
+resource "ncloud_vpc_peering_accepter" "peer" {
+	vpc_peering_no = ncloud_vpc_peering.foo.id
+}
+`
+}
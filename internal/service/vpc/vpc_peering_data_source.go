@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
@@ -135,9 +134,7 @@ func (v *vpcPeeringDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		reqParams.SourceVpcName = data.SourceVpcName.ValueStringPointer()
 	}
 
-	tflog.Info(ctx, "GetVpcPeeringList", map[string]any{
-		"reqParams": common.MarshalUncheckedString(reqParams),
-	})
+	common.LogCommonRequestContext(ctx, "GetVpcPeeringList", reqParams)
 
 	response, err := v.config.Client.Vpc.V2Api.GetVpcPeeringInstanceList(reqParams)
 
@@ -145,14 +142,12 @@ func (v *vpcPeeringDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		var diags diag.Diagnostics
 		diags.AddError(
 			"GetVpcPeeringList",
-			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.MarshalUncheckedString(reqParams)),
+			fmt.Sprintf("error: %s, reqParams: %s", err.Error(), common.RedactLogValue(reqParams)),
 		)
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Info(ctx, "GetVpcPeeringList response", map[string]any{
-		"vpcPeeringResponse": common.MarshalUncheckedString(response),
-	})
+	common.LogResponseContext(ctx, "GetVpcPeeringList", response)
 
 	vpcPeeringList, diags := flattenVpcPeerings(ctx, response.VpcPeeringInstanceList, v.config)
 	resp.Diagnostics.Append(diags...)
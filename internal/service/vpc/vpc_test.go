@@ -10,9 +10,11 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest/mockapi"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	vpcservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
 )
@@ -46,6 +48,35 @@ func TestAccResourceNcloudVpc_basic(t *testing.T) {
 	})
 }
 
+func TestUnitResourceNcloudVpc_basic(t *testing.T) {
+	var vpc vpc.Vpc
+	cidr := "10.0.0.0/16"
+	name := "test-vpc-unit"
+	resourceName := "ncloud_vpc.test"
+
+	server := mockapi.NewServer()
+	defer server.Close()
+	factories, provider := acctest.MockProtoV6ProviderFactories(server)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.MockPreCheck(t) },
+		ProtoV6ProviderFactories: factories,
+		CheckDestroy:             testAccCheckVpcDestroyWithProvider(provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudVpcConfig(name, cidr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExistsWithProvider(resourceName, &vpc, provider),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_cidr_block", cidr),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestMatchResourceAttr(resourceName, "default_network_acl_no", regexp.MustCompile(`^\d+$`)),
+					resource.TestMatchResourceAttr(resourceName, "default_access_control_group_no", regexp.MustCompile(`^\d+$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNcloudVpc_disappears(t *testing.T) {
 	var vpc vpc.Vpc
 	rInt := rand.Intn(16)
@@ -102,6 +133,10 @@ resource "ncloud_vpc" "test" {
 }
 
 func testAccCheckVpcExists(n string, vpc *vpc.Vpc) resource.TestCheckFunc {
+	return testAccCheckVpcExistsWithProvider(n, vpc, acctest.GetTestProvider(true))
+}
+
+func testAccCheckVpcExistsWithProvider(n string, vpc *vpc.Vpc, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -112,7 +147,7 @@ func testAccCheckVpcExists(n string, vpc *vpc.Vpc) resource.TestCheckFunc {
 			return fmt.Errorf("No VPC ID is set")
 		}
 
-		config := acctest.GetTestProvider(true).Meta().(*conn.ProviderConfig)
+		config := provider.Meta().(*conn.ProviderConfig)
 		vpcInstance, err := vpcservice.GetVpcInstance(config, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -125,25 +160,31 @@ func testAccCheckVpcExists(n string, vpc *vpc.Vpc) resource.TestCheckFunc {
 }
 
 func testAccCheckVpcDestroy(s *terraform.State) error {
-	config := acctest.GetTestProvider(true).Meta().(*conn.ProviderConfig)
+	return testAccCheckVpcDestroyWithProvider(acctest.GetTestProvider(true))(s)
+}
 
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "ncloud_vpc" {
-			continue
-		}
+func testAccCheckVpcDestroyWithProvider(provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := provider.Meta().(*conn.ProviderConfig)
 
-		instance, err := vpcservice.GetVpcInstance(config, rs.Primary.ID)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "ncloud_vpc" {
+				continue
+			}
 
-		if err != nil {
-			return err
-		}
+			instance, err := vpcservice.GetVpcInstance(config, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
 
-		if instance != nil {
-			return errors.New("VPC still exists")
+			if instance != nil {
+				return errors.New("VPC still exists")
+			}
 		}
-	}
 
-	return nil
+		return nil
+	}
 }
 
 func testAccCheckVpcDisappears(instance *vpc.Vpc) resource.TestCheckFunc {
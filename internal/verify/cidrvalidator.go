@@ -84,3 +84,18 @@ func CIDRBlocksEqual(cidr1, cidr2 string) bool {
 
 	return ip2.String() == ip1.String() && ipnet2.String() == ipnet1.String()
 }
+
+// CIDRBlocksOverlap returns whether or not two CIDR blocks share any address.
+// Both CIDR blocks must parse to an IP address and network
+func CIDRBlocksOverlap(cidr1, cidr2 string) bool {
+	_, ipnet1, err := net.ParseCIDR(cidr1)
+	if err != nil {
+		return false
+	}
+	_, ipnet2, err := net.ParseCIDR(cidr2)
+	if err != nil {
+		return false
+	}
+
+	return ipnet1.Contains(ipnet2.IP) || ipnet2.Contains(ipnet1.IP)
+}
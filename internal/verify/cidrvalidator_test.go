@@ -0,0 +1,48 @@
+package verify_test
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+func TestValidateCIDRBlock(t *testing.T) {
+	cases := []struct {
+		Value    string
+		HasError bool
+	}{
+		{Value: "10.0.0.0/16", HasError: false},
+		{Value: "10.0.1.0/24", HasError: false},
+		{Value: "10.0.1.1/24", HasError: true},
+		{Value: "10.0.1.0", HasError: true},
+		{Value: "10.0.1.0/33", HasError: true},
+	}
+
+	for _, tc := range cases {
+		err := verify.ValidateCIDRBlock(tc.Value)
+		if (err != nil) != tc.HasError {
+			t.Fatalf("ValidateCIDRBlock(%q) error = %v, expected error: %t", tc.Value, err, tc.HasError)
+		}
+	}
+}
+
+func TestCIDRBlocksOverlap(t *testing.T) {
+	cases := []struct {
+		Cidr1    string
+		Cidr2    string
+		Expected bool
+	}{
+		{Cidr1: "10.0.0.0/16", Cidr2: "10.0.1.0/24", Expected: true},
+		{Cidr1: "10.0.1.0/24", Cidr2: "10.0.0.0/16", Expected: true},
+		{Cidr1: "10.0.1.0/24", Cidr2: "10.0.1.0/24", Expected: true},
+		{Cidr1: "10.0.1.0/24", Cidr2: "10.0.2.0/24", Expected: false},
+		{Cidr1: "10.0.0.0/23", Cidr2: "10.0.2.0/24", Expected: false},
+		{Cidr1: "10.0.0.0/16", Cidr2: "invalid", Expected: false},
+	}
+
+	for _, tc := range cases {
+		if actual := verify.CIDRBlocksOverlap(tc.Cidr1, tc.Cidr2); actual != tc.Expected {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) = %t, expected %t", tc.Cidr1, tc.Cidr2, actual, tc.Expected)
+		}
+	}
+}
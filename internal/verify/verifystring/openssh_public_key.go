@@ -0,0 +1,38 @@
+package verifystring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/crypto/ssh"
+)
+
+type openSSHPublicKeyValidator struct{}
+
+// IsOpenSSHPublicKey returns a validator which ensures that the string is a public key in the OpenSSH authorized_keys format
+func IsOpenSSHPublicKey() validator.String {
+	return openSSHPublicKeyValidator{}
+}
+
+func (v openSSHPublicKeyValidator) Description(ctx context.Context) string {
+	return "Value must be a public key in OpenSSH format. e.g. 'ssh-rsa AAAA...'"
+}
+
+func (v openSSHPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v openSSHPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("Value must be a public key in OpenSSH format: %s", err),
+		)
+	}
+}
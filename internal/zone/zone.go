@@ -19,8 +19,6 @@ type Zone struct {
 	RegionCode      *string `json:"regionCode,omitempty"`
 }
 
-var zoneCache = make(map[string]string)
-
 func ParseZoneNoParameter(config *conn.ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if zoneCode, zoneCodeOk := d.GetOk("zone"); zoneCodeOk {
 		zoneNo := GetZoneNoByCode(config, zoneCode.(string))
@@ -34,11 +32,11 @@ func ParseZoneNoParameter(config *conn.ProviderConfig, d *schema.ResourceData) (
 }
 
 func GetZoneNoByCode(config *conn.ProviderConfig, code string) string {
-	if zoneNo := zoneCache[code]; zoneNo != "" {
+	if zoneNo := config.GetCachedZoneNo(code); zoneNo != "" {
 		return zoneNo
 	}
 	if zone, err := GetZoneByCode(config, code); err == nil && zone != nil {
-		zoneCache[code] = *zone.ZoneNo
+		config.SetCachedZoneNo(code, *zone.ZoneNo)
 		return *zone.ZoneNo
 	}
 	return ""